@@ -0,0 +1,229 @@
+package sop
+
+// Bag is a multiset which stores the number of
+// occurences of each distinct element instead of
+// the duplicates themselves. Elements are kept in
+// the order they were first added.
+//
+// The zero value of Bag is an empty Bag
+// ready to use.
+type Bag[T comparable] struct {
+	idx   map[T]bagEntry
+	order []T
+	n     int
+}
+
+// bagEntry holds the number of occurences of an
+// element and its position in the order of the
+// Bag.
+type bagEntry struct {
+	pos   int
+	count int
+}
+
+// NewBag creates a new empty *Bag[T].
+func NewBag[T comparable]() *Bag[T] {
+	return &Bag[T]{}
+}
+
+// BagFrom creates a new *Bag[T] counting
+// the elements of the Enumerable e.
+func BagFrom[T comparable](e Enumerable[T]) (b *Bag[T]) {
+	b = NewBag[T]()
+	e.Each(func(v T, _ int) {
+		b.Add(v, 1)
+	})
+	return
+}
+
+// Len returns the number of elements in the
+// Bag including duplicates.
+func (b *Bag[T]) Len() int {
+	return b.n
+}
+
+// Add adds the value v n times to the Bag.
+func (b *Bag[T]) Add(v T, n int) {
+	positive("n", n)
+	e, ok := b.idx[v]
+	if !ok {
+		if b.idx == nil {
+			b.idx = make(map[T]bagEntry)
+		}
+		e.pos = len(b.order)
+		b.order = append(b.order, v)
+	}
+	e.count += n
+	b.idx[v] = e
+	b.n += n
+}
+
+// Remove removes the value v n times from the
+// Bag and returns the number of actually removed
+// occurences, which is less than n if v is
+// contained less than n times.
+func (b *Bag[T]) Remove(v T, n int) int {
+	positive("n", n)
+	e, ok := b.idx[v]
+	if !ok {
+		return 0
+	}
+	if n >= e.count {
+		n = e.count
+		delete(b.idx, v)
+		b.compact()
+	} else {
+		e.count -= n
+		b.idx[v] = e
+	}
+	b.n -= n
+	return n
+}
+
+// compact removes the elements from the order
+// which are no longer contained in the Bag once
+// they make up more than half of it, so that
+// Remove takes amortized constant time.
+func (b *Bag[T]) compact() {
+	if len(b.order) <= 2*len(b.idx) {
+		return
+	}
+	order := make([]T, 0, len(b.idx))
+	b.Each(func(v T, n, i int) {
+		b.idx[v] = bagEntry{i, n}
+		order = append(order, v)
+	})
+	b.order = order
+}
+
+// CountOf returns the number of occurences
+// of the value v in the Bag.
+func (b *Bag[T]) CountOf(v T) int {
+	return b.idx[v].count
+}
+
+// Contains returns true if the value v is
+// contained at least once in the Bag.
+func (b *Bag[T]) Contains(v T) (ok bool) {
+	_, ok = b.idx[v]
+	return
+}
+
+// Elements returns the distinct elements of
+// the Bag in the order they were first added.
+func (b *Bag[T]) Elements() *SliceOf[T] {
+	res := make([]T, 0, len(b.idx))
+	b.Each(func(v T, _, _ int) {
+		res = append(res, v)
+	})
+	return Slice(res)
+}
+
+// Unwrap returns all elements of the Bag
+// including duplicates as slice []T. Duplicates
+// are placed next to each other.
+func (b *Bag[T]) Unwrap() []T {
+	res := make([]T, 0, b.n)
+	b.Each(func(v T, n, _ int) {
+		for j := 0; j < n; j++ {
+			res = append(res, v)
+		}
+	})
+	return res
+}
+
+// Each performs the given function f on each
+// distinct element in the Bag.
+//
+// f is getting passed the value v, its number
+// of occurences n as well as the current index i.
+func (b *Bag[T]) Each(f func(v T, n, i int)) {
+	notNil("f", f)
+	var i int
+	for pos, v := range b.order {
+		if e, ok := b.idx[v]; ok && e.pos == pos {
+			f(v, e.count, i)
+			i++
+		}
+	}
+}
+
+// Frequencies returns Tuples of each distinct
+// element of the Bag and its number of occurences
+// in the order the elements were first added.
+func (b *Bag[T]) Frequencies() *SliceOf[Tuple[T, int]] {
+	res := make([]Tuple[T, int], 0, len(b.idx))
+	b.Each(func(v T, n, _ int) {
+		res = append(res, Tuple[T, int]{v, n})
+	})
+	return Slice(res)
+}
+
+// MostCommon returns the k distinct elements with
+// the most occurences as Tuples of the element and
+// its number of occurences in descending order.
+// Elements with equal counts are ordered by when
+// they were first added. If k is negative or
+// larger than the number of distinct elements,
+// all elements are returned.
+func (b *Bag[T]) MostCommon(k int) *SliceOf[Tuple[T, int]] {
+	res := b.Frequencies().SortStable(func(p, q Tuple[T, int], _ int) bool {
+		return p.V2 > q.V2
+	}).Unwrap()
+	if k >= 0 && k < len(res) {
+		res = res[:k]
+	}
+	return Slice(res)
+}
+
+// Union returns a new Bag containing each element
+// of both Bags with the maximum of its numbers of
+// occurences in b and o.
+func (b *Bag[T]) Union(o *Bag[T]) *Bag[T] {
+	return b.combine(o, func(x, y int) int {
+		if x > y {
+			return x
+		}
+		return y
+	})
+}
+
+// Intersect returns a new Bag containing each element
+// contained in both Bags with the minimum of its
+// numbers of occurences in b and o.
+func (b *Bag[T]) Intersect(o *Bag[T]) *Bag[T] {
+	return b.combine(o, func(x, y int) int {
+		if x < y {
+			return x
+		}
+		return y
+	})
+}
+
+// Sum returns a new Bag containing each element
+// of both Bags with the sum of its numbers of
+// occurences in b and o.
+func (b *Bag[T]) Sum(o *Bag[T]) *Bag[T] {
+	return b.combine(o, func(x, y int) int {
+		return x + y
+	})
+}
+
+// combine creates a new Bag with the counts of
+// all elements of b followed by the elements of
+// o as result of f. Elements with a count of 0
+// are not added.
+func (b *Bag[T]) combine(o *Bag[T], f func(x, y int) int) (res *Bag[T]) {
+	res = NewBag[T]()
+	add := func(v T, _, _ int) {
+		if res.Contains(v) {
+			return
+		}
+		if n := f(b.CountOf(v), o.CountOf(v)); n > 0 {
+			res.Add(v, n)
+		}
+	}
+	b.Each(add)
+	o.Each(add)
+	return
+}
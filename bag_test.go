@@ -0,0 +1,106 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBagZero(t *testing.T) {
+	var b Bag[string]
+	assert.Equal(t, 0, b.Len())
+	assert.Equal(t, 0, b.CountOf("a"))
+	assert.Equal(t, 0, b.Remove("a", 1))
+
+	b.Add("a", 2)
+	assert.Equal(t, 2, b.Len())
+	assert.True(t, b.Contains("a"))
+}
+
+func TestBagAddRemove(t *testing.T) {
+	b := BagFrom[string](Slice([]string{"b", "a", "b", "c", "b"}))
+	assert.Equal(t, 5, b.Len())
+	assert.Equal(t, 3, b.CountOf("b"))
+	assert.Equal(t, []string{"b", "a", "c"}, b.Elements().Unwrap())
+	assert.Equal(t, []string{"b", "b", "b", "a", "c"}, b.Unwrap())
+
+	b.Add("a", 3)
+	assert.Equal(t, 4, b.CountOf("a"))
+	assert.Equal(t, 8, b.Len())
+
+	assert.Equal(t, 2, b.Remove("b", 2))
+	assert.Equal(t, 1, b.CountOf("b"))
+	assert.Equal(t, 1, b.Remove("b", 5))
+	assert.False(t, b.Contains("b"))
+	assert.Equal(t, 5, b.Len())
+	assert.Equal(t, []string{"a", "c"}, b.Elements().Unwrap())
+
+	assert.Panics(t, func() {
+		b.Add("a", 0)
+	})
+	assert.Panics(t, func() {
+		b.Remove("a", -1)
+	})
+}
+
+func TestBagFrequencies(t *testing.T) {
+	b := BagFrom[int](Slice([]int{1, 2, 3, 2, 3, 4, 3}))
+	assert.Equal(t, []Tuple[int, int]{
+		{1, 1}, {2, 2}, {3, 3}, {4, 1},
+	}, b.Frequencies().Unwrap())
+
+	assert.Equal(t, []Tuple[int, int]{
+		{3, 3}, {2, 2},
+	}, b.MostCommon(2).Unwrap())
+	assert.Equal(t, []Tuple[int, int]{
+		{3, 3}, {2, 2}, {1, 1}, {4, 1},
+	}, b.MostCommon(-1).Unwrap())
+	assert.Equal(t, []Tuple[int, int]{}, b.MostCommon(0).Unwrap())
+}
+
+func TestBagAlgebra(t *testing.T) {
+	a := BagFrom[string](Slice([]string{"a", "a", "b", "c"}))
+	b := BagFrom[string](Slice([]string{"b", "b", "a", "d"}))
+
+	assert.Equal(t, []Tuple[string, int]{
+		{"a", 2}, {"b", 2}, {"c", 1}, {"d", 1},
+	}, a.Union(b).Frequencies().Unwrap())
+
+	assert.Equal(t, []Tuple[string, int]{
+		{"a", 1}, {"b", 1},
+	}, a.Intersect(b).Frequencies().Unwrap())
+
+	s := a.Sum(b)
+	assert.Equal(t, []Tuple[string, int]{
+		{"a", 3}, {"b", 3}, {"c", 1}, {"d", 1},
+	}, s.Frequencies().Unwrap())
+	assert.Equal(t, 8, s.Len())
+	assert.Equal(t, 4, a.Len())
+}
+
+func TestBagRemoveReAdd(t *testing.T) {
+	b := NewBag[int]()
+	for i := 0; i < 10; i++ {
+		b.Add(i, i+1)
+	}
+	for i := 0; i < 8; i++ {
+		b.Remove(i, 100)
+	}
+	assert.Equal(t, []int{8, 9}, b.Elements().Unwrap())
+
+	b.Add(3, 1)
+	b.Add(8, 1)
+	assert.Equal(t, 2, b.Remove(9, 2))
+	b.Add(9, 1)
+	assert.Equal(t, []Tuple[int, int]{
+		{8, 10}, {9, 9}, {3, 1},
+	}, b.Frequencies().Unwrap())
+	assert.Equal(t, 20, b.Len())
+
+	b.Remove(8, 10)
+	b.Remove(3, 1)
+	b.Add(8, 2)
+	assert.Equal(t, []Tuple[int, int]{
+		{9, 9}, {8, 2},
+	}, b.Frequencies().Unwrap())
+}
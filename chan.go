@@ -0,0 +1,64 @@
+package sop
+
+import "context"
+
+// FromChan creates a new *Query[T] with the
+// channel ch as source. Elements are received
+// one by one when the Query is evaluated, so
+// the channel is never buffered as a whole.
+//
+// Because received elements are consumed, each
+// evaluation of the Query continues with the
+// elements remaining in ch. The evaluation
+// finishes when ch is closed.
+func FromChan[T any](ch <-chan T) *Query[T] {
+	return &Query[T]{func() iterator[T] {
+		return func() (v T, ok bool) {
+			v, ok = <-ch
+			return
+		}
+	}}
+}
+
+// Collect receives all elements from the
+// channel ch until it is closed and packs
+// them into an Enumerable[T].
+func Collect[T any](ch <-chan T) *SliceOf[T] {
+	return FromChan(ch).Collect()
+}
+
+// ToChan evaluates the Query in a new goroutine
+// and sends each resulting element into the
+// returned channel as soon as it is produced.
+// The channel is closed after the last element.
+//
+// You can also pass a buffer size for the
+// returned channel if you desire.
+func (q *Query[T]) ToChan(buf ...int) <-chan T {
+	return q.ToChanCtx(context.Background(), buf...)
+}
+
+// ToChanCtx works like ToChan but stops the
+// evaluation and closes the channel when the
+// given context ctx is done, so that the
+// goroutine does not leak when the receiver
+// stops reading.
+func (q *Query[T]) ToChanCtx(ctx context.Context, buf ...int) <-chan T {
+	var size int
+	if len(buf) != 0 {
+		size = buf[0]
+	}
+	ch := make(chan T, size)
+	go func() {
+		defer close(ch)
+		next := q.iter()
+		for v, ok := next(); ok && ctx.Err() == nil; v, ok = next() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,67 @@
+package sop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func feed(vals ...int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, v := range vals {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+func TestFromChan(t *testing.T) {
+	q := FromChan(feed(1, 2, 3, 4, 5, 6)).
+		Filter(func(v, _ int) bool {
+			return v%2 == 0
+		})
+	assert.Equal(t, []int{2, 4, 6}, q.Unwrap())
+
+	ch := feed(1, 2, 3, 4, 5)
+	q = FromChan(ch)
+	assert.Equal(t, []int{1, 2}, q.Take(2).Unwrap())
+	assert.Equal(t, []int{3, 4, 5}, q.Unwrap())
+	assert.Equal(t, []int(nil), q.Unwrap())
+}
+
+func TestCollect(t *testing.T) {
+	r := Collect(feed(3, 1, 2))
+	assert.Equal(t, []int{3, 1, 2}, r.Unwrap())
+}
+
+func TestToChan(t *testing.T) {
+	q := LazyMap(FromChan(feed(1, 2, 3)), func(v, _ int) int {
+		return v * 2
+	})
+
+	var res []int
+	for v := range q.ToChan() {
+		res = append(res, v)
+	}
+	assert.Equal(t, []int{2, 4, 6}, res)
+
+	r := Collect(Lazy[int](Range(0, 5)).ToChan(2))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, r.Unwrap())
+}
+
+func TestToChanCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Lazy[int](Range(0, 100)).ToChanCtx(ctx)
+	assert.Equal(t, 0, <-ch)
+	assert.Equal(t, 1, <-ch)
+	cancel()
+
+	var n int
+	for range ch {
+		n++
+	}
+	assert.LessOrEqual(t, n, 1)
+}
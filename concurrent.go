@@ -0,0 +1,248 @@
+package sop
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Concurrent wraps an Enumerable and guards
+// all of its operations with a read-write lock,
+// so that it can safely be used from multiple
+// goroutines.
+//
+// Callbacks passed to the operations of a
+// Concurrent are performed while the lock is
+// held, so they must not call operations of
+// the same Concurrent. Use Update for
+// multiple mutations in one transaction.
+type Concurrent[T any] struct {
+	mtx sync.RWMutex
+	e   Enumerable[T]
+}
+
+var _ Enumerable[any] = (*Concurrent[any])(nil)
+
+// NewConcurrent wraps the given Enumerable e
+// into a *Concurrent[T]. e must not be accessed
+// directly afterwards.
+func NewConcurrent[T any](e Enumerable[T]) *Concurrent[T] {
+	return &Concurrent[T]{e: e}
+}
+
+// Snapshot returns a copy of the current
+// elements of the Concurrent which can be
+// iterated without holding the lock.
+func (c *Concurrent[T]) Snapshot() *SliceOf[T] {
+	return Slice(c.Unwrap())
+}
+
+// Update performs f on the wrapped Enumerable
+// while holding the write lock, so that all
+// operations in f are performed as one
+// transaction.
+//
+// e must not be used outside of f.
+func (c *Concurrent[T]) Update(f func(e Enumerable[T])) {
+	notNil("f", f)
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	f(c.e)
+}
+
+// Read performs f on the wrapped Enumerable
+// while holding the read lock, so that all
+// operations in f see a consistent state.
+//
+// e must not be used outside of f.
+func (c *Concurrent[T]) Read(f func(e ReadOnly[T])) {
+	notNil("f", f)
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	f(c.e)
+}
+
+// Unwrap returns a copy of the elements
+// of the Concurrent as slice []T.
+func (c *Concurrent[T]) Unwrap() []T {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return copySlice(c.e.Unwrap())
+}
+
+// Len returns the length of the given Concurrent.
+func (c *Concurrent[T]) Len() int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Len()
+}
+
+// Each performs the given function f on each
+// element in the Concurrent while holding the
+// read lock.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func (c *Concurrent[T]) Each(f func(v T, i int)) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	c.e.Each(f)
+}
+
+// Filter performs preticate p on each element
+// in the Concurrent and each element where p
+// returns true will be added to the result
+// Enumerable.
+func (c *Concurrent[T]) Filter(p func(v T, i int) bool) Enumerable[T] {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Filter(p)
+}
+
+// Any returns true when at least one element in
+// the given Concurrent result in a true return of p
+// when performed on p.
+func (c *Concurrent[T]) Any(p func(v T, i int) bool) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Any(p)
+}
+
+// All returns true when all elements in the given
+// Concurrent result in a true return of p when
+// performed on p.
+func (c *Concurrent[T]) All(p func(v T, i int) bool) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.All(p)
+}
+
+// None returns true when no element in the given
+// Concurrent results in a true return of p when
+// performed on p.
+func (c *Concurrent[T]) None(p func(v T, i int) bool) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.None(p)
+}
+
+// First returns the value and index of the first
+// occurence in the Concurrent where preticate p
+// returns true.
+//
+// If this applies to no element in the Concurrent,
+// default of T and -1 is returned.
+func (c *Concurrent[T]) First(p func(v T, i int) bool) (T, int) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.First(p)
+}
+
+// Count returns the number of elements in the given
+// Concurrent which, when applied on p, return true.
+func (c *Concurrent[T]) Count(p func(v T, i int) bool) int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Count(p)
+}
+
+// Shuffle re-arranges the given Concurrent in a
+// pseudo-random order and returns the result
+// Enumerable.
+//
+// You can also pass a custom random source rngSrc if
+// you desire.
+func (c *Concurrent[T]) Shuffle(rngSrc ...rand.Source) Enumerable[T] {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Shuffle(rngSrc...)
+}
+
+// Sort re-orders the Concurrent given the provided
+// less function and returns the result Enumerable.
+func (c *Concurrent[T]) Sort(less func(p, q T, i int) bool) Enumerable[T] {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Sort(less)
+}
+
+// SortStable re-orders the Concurrent given the
+// provided less function while keeping the original
+// order of equal elements and returns the result
+// Enumerable.
+func (c *Concurrent[T]) SortStable(less func(p, q T, i int) bool) Enumerable[T] {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.SortStable(less)
+}
+
+// Aggregate applies the multiplicator function f over
+// all elements of the given Concurrent and returns
+// the final result.
+func (c *Concurrent[T]) Aggregate(f func(a, b T) T) T {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.Aggregate(f)
+}
+
+// At safely accesses the element in the Concurrent
+// at the given index i and returns it, if existent.
+// If there is no value at i, default of T and false
+// is returned.
+func (c *Concurrent[T]) At(i int) (T, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.e.At(i)
+}
+
+// Push appends the passed value v to the Concurrent.
+func (c *Concurrent[T]) Push(v T) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.e.Push(v)
+}
+
+// Pop removes the last element of the Concurrent and
+// returns its value. If the Concurrent is empty, the
+// default value of T is returned.
+func (c *Concurrent[T]) Pop() T {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.e.Pop()
+}
+
+// Append adds all elements of Enumerable v to the
+// end of the Concurrent. v may also be the same
+// Concurrent.
+func (c *Concurrent[T]) Append(v Enumerable[T]) {
+	vs := Slice(v.Unwrap())
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.e.Append(vs)
+}
+
+// Flush removes all elements of the given Concurrent.
+func (c *Concurrent[T]) Flush() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.e.Flush()
+}
+
+// Splice removes the values from the given Concurrent
+// starting at i with the amount of n. The removed
+// values are returned as new Enumerable.
+func (c *Concurrent[T]) Splice(i, n int) Enumerable[T] {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.e.Splice(i, n)
+}
+
+// Replace safely replaces the value in the Concurrent
+// at the given index i with the given value v and
+// returns true if the value was replaced. If the
+// Concurrent has no value at i, false is returned.
+func (c *Concurrent[T]) Replace(i int, v T) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.e.Replace(i, v)
+}
@@ -0,0 +1,102 @@
+package sop
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrent(t *testing.T) {
+	c := NewConcurrent[int](Slice([]int{3, 1, 2}))
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, []int{2}, c.Filter(even).Unwrap())
+	assert.True(t, c.Any(even))
+	assert.False(t, c.All(even))
+	assert.False(t, c.None(even))
+	assert.Equal(t, 1, c.Count(even))
+	assert.Equal(t, []int{1, 2, 3}, c.Sort(func(p, q, _ int) bool {
+		return p < q
+	}).Unwrap())
+
+	c.Push(4)
+	assert.Equal(t, 4, c.Pop())
+	assert.True(t, c.Replace(0, 5))
+	assert.Equal(t, []int{5}, c.Splice(0, 1).Unwrap())
+
+	c.Append(c)
+	assert.Equal(t, []int{1, 2, 1, 2}, c.Unwrap())
+
+	u := c.Unwrap()
+	u[0] = 9
+	v, _ := c.At(0)
+	assert.Equal(t, 1, v)
+
+	c.Flush()
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestConcurrentSet(t *testing.T) {
+	c := NewConcurrent[int](Set([]int{1, 2}))
+	c.Push(1)
+	c.Push(3)
+	assert.Equal(t, []int{1, 2, 3}, c.Unwrap())
+}
+
+func TestConcurrentUpdate(t *testing.T) {
+	c := NewConcurrent[int](Slice([]int{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Update(func(e Enumerable[int]) {
+				e.Push(e.Len())
+			})
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, Range(0, 50).Unwrap(), c.Unwrap())
+
+	var sum int
+	c.Read(func(e ReadOnly[int]) {
+		sum = e.Aggregate(func(a, b int) int {
+			return a + b
+		})
+	})
+	assert.Equal(t, Sum[int](Range(0, 50)), sum)
+}
+
+func TestConcurrentRace(t *testing.T) {
+	c := NewConcurrent[int](Slice([]int{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.Push(i*100 + j)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				c.Each(func(_, _ int) {})
+				c.Snapshot().Each(func(_, _ int) {})
+				c.Append(Slice([]int{}))
+				c.Len()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1000, c.Len())
+	assert.ElementsMatch(t, Range(0, 1000).Unwrap(), c.Snapshot().Unwrap())
+}
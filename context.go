@@ -0,0 +1,145 @@
+package sop
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// EachCtx works like Each but checks the given
+// context ctx before each element. When ctx is
+// done, the iteration stops and ctx.Err() is
+// returned.
+func EachCtx[T any](ctx context.Context, s Enumerable[T], f func(v T, i int)) (err error) {
+	notNil("f", f)
+	s.Any(func(v T, i int) bool {
+		if err = ctx.Err(); err != nil {
+			return true
+		}
+		f(v, i)
+		return false
+	})
+	return
+}
+
+// FilterCtx works like Filter but checks the
+// given context ctx before each element. When
+// ctx is done, the iteration stops and ctx.Err()
+// is returned.
+func FilterCtx[T any](
+	ctx context.Context,
+	s Enumerable[T],
+	p func(v T, i int) bool,
+) (*SliceOf[T], error) {
+	notNil("p", p)
+	res := newSliceFrom[T, T](s)
+	var j int
+	err := EachCtx(ctx, s, func(v T, i int) {
+		if p(v, i) {
+			res[j] = v
+			j++
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return Slice(res[:j]), nil
+}
+
+// MapCtx works like Map but checks the given
+// context ctx before each element. When ctx is
+// done, the iteration stops and ctx.Err() is
+// returned.
+func MapCtx[TIn, TOut any](
+	ctx context.Context,
+	s Enumerable[TIn],
+	f func(v TIn, i int) TOut,
+) (*SliceOf[TOut], error) {
+	notNil("f", f)
+	res := newSliceFrom[TIn, TOut](s)
+	err := EachCtx(ctx, s, func(v TIn, i int) {
+		res[i] = f(v, i)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return Slice(res), nil
+}
+
+// ParallelEachCtx works like ParallelEach but each
+// worker checks the given context ctx before each
+// element. When ctx is done, all workers stop and
+// ctx.Err() is returned. If all elements have been
+// processed, nil is returned even if ctx is done
+// afterwards.
+func ParallelEachCtx[T any](
+	ctx context.Context,
+	s Enumerable[T],
+	f func(v T, i int),
+	workers ...int,
+) error {
+	notNil("f", f)
+	src := s.Unwrap()
+	var stopped int32
+	runSharded(len(src), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			if ctx.Err() != nil {
+				atomic.StoreInt32(&stopped, 1)
+				return
+			}
+			f(src[i], i)
+		}
+	})
+	if atomic.LoadInt32(&stopped) != 0 {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ParallelFilterCtx works like ParallelFilter but
+// each worker checks the given context ctx before
+// each element. When ctx is done, all workers stop
+// and ctx.Err() is returned.
+func ParallelFilterCtx[T any](
+	ctx context.Context,
+	s Enumerable[T],
+	p func(v T, i int) bool,
+	workers ...int,
+) (*SliceOf[T], error) {
+	notNil("p", p)
+	src := s.Unwrap()
+	keep := make([]bool, len(src))
+	err := ParallelEachCtx[T](ctx, Slice(src), func(v T, i int) {
+		keep[i] = p(v, i)
+	}, workers...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]T, 0, len(src))
+	for i, ok := range keep {
+		if ok {
+			res = append(res, src[i])
+		}
+	}
+	return Slice(res), nil
+}
+
+// ParallelMapCtx works like ParallelMap but each
+// worker checks the given context ctx before each
+// element. When ctx is done, all workers stop and
+// ctx.Err() is returned.
+func ParallelMapCtx[TIn, TOut any](
+	ctx context.Context,
+	s Enumerable[TIn],
+	f func(v TIn, i int) TOut,
+	workers ...int,
+) (*SliceOf[TOut], error) {
+	notNil("f", f)
+	res := newSliceFrom[TIn, TOut](s)
+	err := ParallelEachCtx(ctx, s, func(v TIn, i int) {
+		res[i] = f(v, i)
+	}, workers...)
+	if err != nil {
+		return nil, err
+	}
+	return Slice(res), nil
+}
@@ -0,0 +1,130 @@
+package sop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEachCtx(t *testing.T) {
+	w := Range(0, 10)
+
+	var visited []int
+	err := EachCtx[int](context.Background(), w, func(v, _ int) {
+		visited = append(visited, v)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, w.Unwrap(), visited)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	visited = nil
+	err = EachCtx[int](ctx, w, func(v, _ int) {
+		visited = append(visited, v)
+		if v == 3 {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{0, 1, 2, 3}, visited)
+
+	assert.Panics(t, func() {
+		EachCtx[int](context.Background(), Slice([]int{1}), nil)
+	})
+}
+
+func TestFilterCtx(t *testing.T) {
+	w := Range(0, 10)
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	r, err := FilterCtx[int](context.Background(), w, even)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, r.Unwrap())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r, err = FilterCtx[int](ctx, w, even)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, r)
+}
+
+func TestMapCtx(t *testing.T) {
+	w := Range(0, 5)
+	double := func(v, _ int) int {
+		return v * 2
+	}
+
+	r, err := MapCtx[int](context.Background(), w, double)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, r.Unwrap())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r, err = MapCtx[int](ctx, w, double)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, r)
+}
+
+func TestParallelCtx(t *testing.T) {
+	w := Range(0, 1000)
+	double := func(v, _ int) int {
+		return v * 2
+	}
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	r, err := ParallelMapCtx[int](context.Background(), w, double, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, Map[int](w, double).Unwrap(), r.Unwrap())
+
+	r, err = ParallelFilterCtx[int](context.Background(), w, even, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, w.Filter(even).Unwrap(), r.Unwrap())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r, err = ParallelMapCtx[int](ctx, w, double, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, r)
+
+	r, err = ParallelFilterCtx[int](ctx, w, even, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, r)
+
+	var called bool
+	err = ParallelEachCtx[int](ctx, w, func(_, _ int) {
+		called = true
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called)
+}
+
+func TestParallelCtxCancelAfterLast(t *testing.T) {
+	w := Range(0, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := ParallelMapCtx[int](ctx, w, func(v, i int) int {
+		if i == 2 {
+			cancel()
+		}
+		return v * 2
+	}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 4}, r.Unwrap())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	var calls int
+	err = ParallelEachCtx[int](ctx, w, func(_, i int) {
+		calls++
+		if i == 1 {
+			cancel()
+		}
+	}, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,215 @@
+package sop
+
+// Dict is a map which preserves the insertion
+// order of its keys. Entries are stored as
+// key-value Tuples.
+//
+// The zero value of Dict is an empty Dict
+// ready to use.
+type Dict[K comparable, V any] struct {
+	entries []Tuple[K, V]
+	idx     map[K]int
+}
+
+// NewDict creates a new empty *Dict[K, V].
+func NewDict[K comparable, V any]() *Dict[K, V] {
+	return &Dict[K, V]{}
+}
+
+// DictFrom creates a new *Dict[K, V] from the
+// key-value Tuples in the Enumerable e. If a
+// key occurs multiple times, the last value
+// is kept at the position of the first
+// occurence.
+func DictFrom[K comparable, V any](e Enumerable[Tuple[K, V]]) (d *Dict[K, V]) {
+	d = NewDict[K, V]()
+	e.Each(func(v Tuple[K, V], _ int) {
+		d.Set(v.V1, v.V2)
+	})
+	return
+}
+
+// Len returns the number of entries
+// in the Dict.
+func (d *Dict[K, V]) Len() int {
+	return len(d.entries)
+}
+
+// Set sets the value v for the key k. If k
+// is not contained in the Dict, the entry is
+// appended. Otherwise, the value is replaced
+// while the position is kept.
+func (d *Dict[K, V]) Set(k K, v V) {
+	if i, ok := d.idx[k]; ok {
+		d.entries[i].V2 = v
+		return
+	}
+	if d.idx == nil {
+		d.idx = make(map[K]int)
+	}
+	d.idx[k] = len(d.entries)
+	d.entries = append(d.entries, Tuple[K, V]{k, v})
+}
+
+// Get returns the value for the key k and
+// true, if existent. Otherwise, default of
+// V and false is returned.
+func (d *Dict[K, V]) Get(k K) (v V, ok bool) {
+	i, ok := d.idx[k]
+	if ok {
+		v = d.entries[i].V2
+	}
+	return
+}
+
+// GetOr returns the value for the key k or
+// def, if k is not contained in the Dict.
+func (d *Dict[K, V]) GetOr(k K, def V) V {
+	if v, ok := d.Get(k); ok {
+		return v
+	}
+	return def
+}
+
+// Has returns true if the key k is
+// contained in the Dict.
+func (d *Dict[K, V]) Has(k K) (ok bool) {
+	_, ok = d.idx[k]
+	return
+}
+
+// Delete removes the entry with the key k
+// and returns true, if it was contained in
+// the Dict.
+func (d *Dict[K, V]) Delete(k K) bool {
+	i, ok := d.idx[k]
+	if !ok {
+		return false
+	}
+	delete(d.idx, k)
+	d.entries = append(d.entries[:i], d.entries[i+1:]...)
+	for j := i; j < len(d.entries); j++ {
+		d.idx[d.entries[j].V1] = j
+	}
+	return true
+}
+
+// Keys returns all keys of the Dict
+// in insertion order.
+func (d *Dict[K, V]) Keys() *SliceOf[K] {
+	return Map[Tuple[K, V]](d.Entries(), func(e Tuple[K, V], _ int) K {
+		return e.V1
+	})
+}
+
+// Values returns all values of the Dict
+// in insertion order of their keys.
+func (d *Dict[K, V]) Values() *SliceOf[V] {
+	return Map[Tuple[K, V]](d.Entries(), func(e Tuple[K, V], _ int) V {
+		return e.V2
+	})
+}
+
+// Entries returns all key-value Tuples of
+// the Dict in insertion order.
+func (d *Dict[K, V]) Entries() *SliceOf[Tuple[K, V]] {
+	return Slice(copySlice(d.entries))
+}
+
+// Each performs the given function f on each
+// entry in the Dict in insertion order.
+//
+// f is getting passed the key k and value v
+// of the current entry as well as the current
+// index i.
+func (d *Dict[K, V]) Each(f func(k K, v V, i int)) {
+	notNil("f", f)
+	for i, e := range d.entries {
+		f(e.V1, e.V2, i)
+	}
+}
+
+// Filter performs preticate p on each entry
+// in the Dict and each entry where p returns
+// true will be added to the result Dict.
+//
+// p is getting passed the key k and value v
+// of the current entry as well as the current
+// index i.
+func (d *Dict[K, V]) Filter(p func(k K, v V, i int) bool) (res *Dict[K, V]) {
+	notNil("p", p)
+	res = NewDict[K, V]()
+	d.Each(func(k K, v V, i int) {
+		if p(k, v, i) {
+			res.Set(k, v)
+		}
+	})
+	return
+}
+
+// Merge returns a new Dict containing all
+// entries of the Dict followed by all entries
+// of other with keys not contained in the Dict.
+//
+// For keys contained in both Dicts, resolve is
+// called with the key k, the value a of the
+// Dict and the value b of other and its return
+// value is used. If resolve is nil, the value
+// of other is used.
+func (d *Dict[K, V]) Merge(other *Dict[K, V], resolve func(k K, a, b V) V) (res *Dict[K, V]) {
+	res = d.Filter(func(K, V, int) bool {
+		return true
+	})
+	other.Each(func(k K, b V, _ int) {
+		if a, ok := res.Get(k); ok && resolve != nil {
+			b = resolve(k, a, b)
+		}
+		res.Set(k, b)
+	})
+	return
+}
+
+// ToMap returns the entries of the Dict
+// as native map.
+func (d *Dict[K, V]) ToMap() (m map[K]V) {
+	m = make(map[K]V, d.Len())
+	d.Each(func(k K, v V, _ int) {
+		m[k] = v
+	})
+	return
+}
+
+// MapValues creates a new Dict with the same keys
+// as Dict d where the values are the results of
+// function f performed on each entry.
+//
+// f is getting passed the key k and value v
+// of the current entry as well as the current
+// index i.
+func MapValues[K comparable, V, R any](d *Dict[K, V], f func(k K, v V, i int) R) (res *Dict[K, R]) {
+	notNil("f", f)
+	res = NewDict[K, R]()
+	d.Each(func(k K, v V, i int) {
+		res.Set(k, f(k, v, i))
+	})
+	return
+}
+
+// MapKeys creates a new Dict with the same values
+// as Dict d where the keys are the results of
+// function f performed on each entry. If f returns
+// the same key for multiple entries, the last
+// value is kept at the position of the first
+// occurence.
+//
+// f is getting passed the key k and value v
+// of the current entry as well as the current
+// index i.
+func MapKeys[K, R comparable, V any](d *Dict[K, V], f func(k K, v V, i int) R) (res *Dict[R, V]) {
+	notNil("f", f)
+	res = NewDict[R, V]()
+	d.Each(func(k K, v V, i int) {
+		res.Set(f(k, v, i), v)
+	})
+	return
+}
@@ -0,0 +1,149 @@
+package sop
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictZero(t *testing.T) {
+	var d Dict[string, int]
+	assert.Equal(t, 0, d.Len())
+	assert.False(t, d.Has("a"))
+	assert.False(t, d.Delete("a"))
+
+	d.Set("a", 1)
+	assert.Equal(t, 1, d.Len())
+	assert.True(t, d.Has("a"))
+}
+
+func TestDictFrom(t *testing.T) {
+	d := DictFrom[string, int](Slice([]Tuple[string, int]{
+		{"c", 1}, {"a", 2}, {"c", 3}, {"b", 4},
+	}))
+	assert.Equal(t, []Tuple[string, int]{
+		{"c", 3}, {"a", 2}, {"b", 4},
+	}, d.Entries().Unwrap())
+}
+
+func TestDictSetGet(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("b", 1)
+	d.Set("a", 2)
+	d.Set("b", 3)
+
+	v, ok := d.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	v, ok = d.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	assert.Equal(t, 2, d.GetOr("a", 5))
+	assert.Equal(t, 5, d.GetOr("c", 5))
+
+	assert.Equal(t, []string{"b", "a"}, d.Keys().Unwrap())
+	assert.Equal(t, []int{3, 2}, d.Values().Unwrap())
+}
+
+func TestDictDelete(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("a", 1)
+	d.Set("b", 2)
+	d.Set("c", 3)
+
+	assert.True(t, d.Delete("a"))
+	assert.False(t, d.Delete("a"))
+	assert.Equal(t, []string{"b", "c"}, d.Keys().Unwrap())
+
+	d.Set("c", 4)
+	d.Set("a", 5)
+	assert.Equal(t, []Tuple[string, int]{
+		{"b", 2}, {"c", 4}, {"a", 5},
+	}, d.Entries().Unwrap())
+}
+
+func TestDictEntries(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("a", 1)
+
+	e := d.Entries()
+	e.Replace(0, Tuple[string, int]{"a", 2})
+	assert.Equal(t, 1, d.GetOr("a", 0))
+}
+
+func TestDictFilter(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("a", 1)
+	d.Set("b", 2)
+	d.Set("c", 3)
+	d.Set("d", 4)
+
+	r := d.Filter(func(k string, v, i int) bool {
+		return v%2 == 0
+	})
+	assert.Equal(t, []string{"b", "d"}, r.Keys().Unwrap())
+	assert.Equal(t, 4, d.Len())
+
+	assert.Panics(t, func() {
+		d.Filter(nil)
+	})
+}
+
+func TestDictMerge(t *testing.T) {
+	a := NewDict[string, int]()
+	a.Set("a", 1)
+	a.Set("b", 2)
+	b := NewDict[string, int]()
+	b.Set("c", 3)
+	b.Set("b", 4)
+
+	r := a.Merge(b, nil)
+	assert.Equal(t, []Tuple[string, int]{
+		{"a", 1}, {"b", 4}, {"c", 3},
+	}, r.Entries().Unwrap())
+
+	r = a.Merge(b, func(_ string, x, y int) int {
+		return x + y
+	})
+	assert.Equal(t, []Tuple[string, int]{
+		{"a", 1}, {"b", 6}, {"c", 3},
+	}, r.Entries().Unwrap())
+	assert.Equal(t, 2, a.GetOr("b", 0))
+}
+
+func TestDictToMap(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("a", 1)
+	d.Set("b", 2)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, d.ToMap())
+}
+
+func TestMapValues(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("b", 1)
+	d.Set("a", 2)
+
+	r := MapValues(d, func(k string, v, i int) string {
+		return k + strconv.Itoa(v+i)
+	})
+	assert.Equal(t, []Tuple[string, string]{
+		{"b", "b1"}, {"a", "a3"},
+	}, r.Entries().Unwrap())
+}
+
+func TestMapKeys(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("a", 1)
+	d.Set("bb", 2)
+	d.Set("c", 3)
+
+	r := MapKeys(d, func(k string, _, _ int) int {
+		return len(k)
+	})
+	assert.Equal(t, []Tuple[int, int]{
+		{1, 3}, {2, 2},
+	}, r.Entries().Unwrap())
+}
@@ -0,0 +1,337 @@
+package sop
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON serializes the Slice as
+// JSON array.
+func (s slice[T]) MarshalJSON() ([]byte, error) {
+	if s.s == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.s)
+}
+
+// UnmarshalJSON replaces the elements of the
+// Slice with the elements of the given JSON
+// array.
+func (s *slice[T]) UnmarshalJSON(data []byte) error {
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	s.s = v
+	return nil
+}
+
+// MarshalYAML returns the elements of the
+// Slice to be serialized as YAML sequence.
+func (s slice[T]) MarshalYAML() (interface{}, error) {
+	if s.s == nil {
+		return []T{}, nil
+	}
+	return s.s, nil
+}
+
+// UnmarshalYAML replaces the elements of the
+// Slice with the elements of the given YAML
+// sequence.
+func (s *slice[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v []T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	s.s = v
+	return nil
+}
+
+// MarshalBinary serializes the elements
+// of the Slice using encoding/gob.
+func (s slice[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the elements of
+// the Slice with the elements deserialized
+// from data using encoding/gob.
+func (s *slice[T]) UnmarshalBinary(data []byte) error {
+	var v []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+		return err
+	}
+	s.s = v
+	return nil
+}
+
+// UnmarshalJSON replaces the elements of the
+// set with the unique elements of the given
+// JSON array.
+func (s *SetOf[T]) UnmarshalJSON(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalJSON(data)
+	})
+}
+
+// UnmarshalYAML replaces the elements of the
+// set with the unique elements of the given
+// YAML sequence.
+func (s *SetOf[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalYAML(unmarshal)
+	})
+}
+
+// UnmarshalBinary replaces the elements of
+// the set with the unique elements deserialized
+// from data using encoding/gob.
+func (s *SetOf[T]) UnmarshalBinary(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// decode replaces the elements of the set with
+// the unique elements decoded by f.
+func (s *SetOf[T]) decode(f func(v *SliceOf[T]) error) error {
+	var v SliceOf[T]
+	if err := f(&v); err != nil {
+		return err
+	}
+	*s = *Set(v.s)
+	return nil
+}
+
+// UnmarshalJSON replaces the elements of the
+// Heap with the elements of the given JSON
+// array and restores the heap order.
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	return h.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalJSON(data)
+	})
+}
+
+// UnmarshalYAML replaces the elements of the
+// Heap with the elements of the given YAML
+// sequence and restores the heap order.
+func (h *Heap[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return h.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalYAML(unmarshal)
+	})
+}
+
+// UnmarshalBinary replaces the elements of
+// the Heap with the elements deserialized from
+// data using encoding/gob and restores the
+// heap order.
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	return h.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// decode replaces the elements of the Heap with
+// the elements decoded by f and restores the
+// heap order.
+func (h *Heap[T]) decode(f func(v *SliceOf[T]) error) error {
+	if h.less == nil {
+		return errNoLess("heap")
+	}
+	var v SliceOf[T]
+	if err := f(&v); err != nil {
+		return err
+	}
+	h.s = v.s
+	heap.Init(h.heap())
+	return nil
+}
+
+// UnmarshalJSON replaces the elements of the
+// SortedSlice with the sorted elements of the
+// given JSON array.
+func (s *SortedSlice[T]) UnmarshalJSON(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalJSON(data)
+	})
+}
+
+// UnmarshalYAML replaces the elements of the
+// SortedSlice with the sorted elements of the
+// given YAML sequence.
+func (s *SortedSlice[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalYAML(unmarshal)
+	})
+}
+
+// UnmarshalBinary replaces the elements of
+// the SortedSlice with the sorted elements
+// deserialized from data using encoding/gob.
+func (s *SortedSlice[T]) UnmarshalBinary(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// decode replaces the elements of the SortedSlice
+// with the elements decoded by f in sorted order.
+func (s *SortedSlice[T]) decode(f func(v *SliceOf[T]) error) error {
+	if s.less == nil {
+		return errNoLess("sorted slice")
+	}
+	var v SliceOf[T]
+	if err := f(&v); err != nil {
+		return err
+	}
+	s.s = SortedSliceFrom[T](&v, s.less).s
+	return nil
+}
+
+// UnmarshalJSON replaces the elements of the
+// SortedSet with the sorted unique elements of
+// the given JSON array.
+func (s *SortedSet[T]) UnmarshalJSON(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalJSON(data)
+	})
+}
+
+// UnmarshalYAML replaces the elements of the
+// SortedSet with the sorted unique elements of
+// the given YAML sequence.
+func (s *SortedSet[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalYAML(unmarshal)
+	})
+}
+
+// UnmarshalBinary replaces the elements of
+// the SortedSet with the sorted unique elements
+// deserialized from data using encoding/gob.
+func (s *SortedSet[T]) UnmarshalBinary(data []byte) error {
+	return s.decode(func(v *SliceOf[T]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// decode replaces the elements of the SortedSet
+// with the unique elements decoded by f in
+// sorted order.
+func (s *SortedSet[T]) decode(f func(v *SliceOf[T]) error) error {
+	if s.less == nil {
+		return errNoLess("sorted set")
+	}
+	var v SliceOf[T]
+	if err := f(&v); err != nil {
+		return err
+	}
+	s.s = SortedSetFrom[T](&v, s.less).s
+	return nil
+}
+
+// MarshalJSON serializes the Tuple as
+// two-element JSON array. Use AsObject to
+// serialize it as JSON object instead.
+func (t Tuple[T1, T2]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]interface{}{t.V1, t.V2})
+}
+
+// UnmarshalJSON deserializes the Tuple from either
+// a two-element JSON array or a JSON object.
+func (t *Tuple[T1, T2]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, (*TupleObject[T1, T2])(t), &t.V1, &t.V2)
+}
+
+// TupleObject has the same fields as Tuple but
+// is serialized to JSON as object with the keys
+// "V1" and "V2" instead of as array.
+type TupleObject[T1, T2 any] struct {
+	V1 T1
+	V2 T2
+}
+
+// AsObject returns the Tuple as TupleObject
+// which is serialized to JSON as object.
+func (t Tuple[T1, T2]) AsObject() TupleObject[T1, T2] {
+	return TupleObject[T1, T2](t)
+}
+
+// MarshalJSON serializes the Tuple3 as
+// three-element JSON array.
+func (t Tuple3[T1, T2, T3]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]interface{}{t.V1, t.V2, t.V3})
+}
+
+// UnmarshalJSON deserializes the Tuple3 from either
+// a three-element JSON array or a JSON object.
+func (t *Tuple3[T1, T2, T3]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, (*tuple3Object[T1, T2, T3])(t), &t.V1, &t.V2, &t.V3)
+}
+
+// MarshalJSON serializes the Tuple4 as
+// four-element JSON array.
+func (t Tuple4[T1, T2, T3, T4]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]interface{}{t.V1, t.V2, t.V3, t.V4})
+}
+
+// UnmarshalJSON deserializes the Tuple4 from either
+// a four-element JSON array or a JSON object.
+func (t *Tuple4[T1, T2, T3, T4]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, (*tuple4Object[T1, T2, T3, T4])(t), &t.V1, &t.V2, &t.V3, &t.V4)
+}
+
+// tuple3Object and tuple4Object have the same
+// fields as Tuple3 and Tuple4 but use the default
+// JSON encoding of structs.
+type (
+	tuple3Object[T1, T2, T3 any] struct {
+		V1 T1
+		V2 T2
+		V3 T3
+	}
+	tuple4Object[T1, T2, T3, T4 any] struct {
+		V1 T1
+		V2 T2
+		V3 T3
+		V4 T4
+	}
+)
+
+// unmarshalTuple deserializes data into obj if
+// it is a JSON object. Otherwise, data must be
+// a JSON array whose elements are deserialized
+// into fields in order.
+func unmarshalTuple(data []byte, obj interface{}, fields ...interface{}) error {
+	data = bytes.TrimSpace(data)
+	if len(data) != 0 && data[0] == '{' {
+		return json.Unmarshal(data, obj)
+	}
+	var v []json.RawMessage
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if len(v) != len(fields) {
+		return fmt.Errorf("tuple must have %d elements but has %d", len(fields), len(v))
+	}
+	for i, f := range fields {
+		if err := json.Unmarshal(v[i], f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errNoLess returns the error returned when
+// decoding into a collection named name which
+// was not created with a less function.
+func errNoLess(name string) error {
+	return fmt.Errorf("%s has no less function", name)
+}
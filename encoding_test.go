@@ -0,0 +1,250 @@
+package sop
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zekrotja/sop/util"
+)
+
+func TestSliceJSON(t *testing.T) {
+	type obj struct {
+		Items *SliceOf[int]
+	}
+
+	data, err := json.Marshal(obj{Slice([]int{1, 2, 3})})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Items":[1,2,3]}`, string(data))
+
+	data, err = json.Marshal(obj{Slice[int](nil)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Items":[]}`, string(data))
+
+	var e Enumerable[string] = Slice([]string{"a"})
+	data, err = json.Marshal(e)
+	assert.Nil(t, err)
+	assert.Equal(t, `["a"]`, string(data))
+
+	var o obj
+	err = json.Unmarshal([]byte(`{"Items":[3,2,1]}`), &o)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 2, 1}, o.Items.Unwrap())
+
+	err = json.Unmarshal([]byte(`{"Items":{}}`), &o)
+	assert.Error(t, err)
+}
+
+func TestSetJSON(t *testing.T) {
+	type obj struct {
+		Items *SetOf[int]
+	}
+
+	data, err := json.Marshal(obj{Set([]int{1, 2, 1})})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Items":[1,2]}`, string(data))
+
+	var o obj
+	err = json.Unmarshal([]byte(`{"Items":[3,2,3,1,2]}`), &o)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 2, 1}, o.Items.Unwrap())
+	assert.True(t, o.Items.Contains(1))
+
+	o.Items.Push(2)
+	assert.Equal(t, []int{3, 2, 1}, o.Items.Unwrap())
+}
+
+func TestValueFieldsJSON(t *testing.T) {
+	type obj struct {
+		IDs  SliceOf[int]
+		Tags SetOf[string]
+	}
+
+	var o obj
+	o.IDs.Push(1)
+	o.IDs.Push(2)
+	o.Tags.Push("a")
+	o.Tags.Push("a")
+
+	data, err := json.Marshal(o)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"IDs":[1,2],"Tags":["a"]}`, string(data))
+
+	data, err = json.Marshal(obj{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"IDs":[],"Tags":[]}`, string(data))
+
+	var r obj
+	err = json.Unmarshal([]byte(`{"IDs":[3],"Tags":["b","b","c"]}`), &r)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3}, r.IDs.Unwrap())
+	assert.Equal(t, []string{"b", "c"}, r.Tags.Unwrap())
+	assert.True(t, r.Tags.Contains("c"))
+
+	y, err := o.Tags.MarshalYAML()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a"}, y)
+
+	var buf bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buf).Encode(o))
+	var g obj
+	assert.Nil(t, gob.NewDecoder(&buf).Decode(&g))
+	assert.Equal(t, []int{1, 2}, g.IDs.Unwrap())
+	assert.Equal(t, []string{"a"}, g.Tags.Unwrap())
+}
+
+func TestSliceYAML(t *testing.T) {
+	s := Slice([]int{1, 2})
+	v, err := s.MarshalYAML()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, v)
+
+	st := Set[int](nil)
+	err = st.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*[]int)) = []int{1, 1, 2}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, st.Unwrap())
+}
+
+func TestSliceGob(t *testing.T) {
+	type obj struct {
+		Items *SliceOf[string]
+		Set   *SetOf[int]
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(obj{
+		Slice([]string{"a", "b"}),
+		Set([]int{1, 2}),
+	})
+	assert.Nil(t, err)
+
+	var o obj
+	err = gob.NewDecoder(&buf).Decode(&o)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, o.Items.Unwrap())
+	assert.Equal(t, []int{1, 2}, o.Set.Unwrap())
+	assert.True(t, o.Set.Contains(2))
+
+	data, err := Slice([]int{1, 1}).MarshalBinary()
+	assert.Nil(t, err)
+	st := Set[int](nil)
+	assert.Nil(t, st.UnmarshalBinary(data))
+	assert.Equal(t, []int{1}, st.Unwrap())
+}
+
+func TestHeapDecode(t *testing.T) {
+	h := NewHeap(util.Asc[int])
+	assert.Nil(t, json.Unmarshal([]byte("[5,1,3]"), h))
+	v, _ := h.Peek()
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []int{1, 3, 5}, drain(h))
+
+	err := h.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*[]int)) = []int{4, 2}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4}, drain(h))
+
+	data, err := Slice([]int{9, 7, 8}).MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, h.UnmarshalBinary(data))
+	assert.Equal(t, []int{7, 8, 9}, drain(h))
+
+	var o struct{ H Heap[int] }
+	assert.EqualError(t, json.Unmarshal([]byte(`{"H":[1]}`), &o), "heap has no less function")
+	assert.EqualError(t, o.H.UnmarshalBinary(data), "heap has no less function")
+	assert.Equal(t, 0, o.H.Len())
+}
+
+func TestSortedDecode(t *testing.T) {
+	s := NewSortedSlice(util.Asc[int])
+	assert.Nil(t, json.Unmarshal([]byte("[5,1,3,1]"), s))
+	assert.Equal(t, []int{1, 1, 3, 5}, s.Unwrap())
+	assert.True(t, s.Contains(1))
+
+	st := NewSortedSet(util.Desc[int])
+	assert.Nil(t, json.Unmarshal([]byte("[5,1,3,1]"), st))
+	assert.Equal(t, []int{5, 3, 1}, st.Unwrap())
+	assert.True(t, st.Contains(1))
+
+	err := st.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*[]int)) = []int{2, 4, 2}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4, 2}, st.Unwrap())
+
+	data, err := Slice([]int{9, 7, 9}).MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, s.UnmarshalBinary(data))
+	assert.Equal(t, []int{7, 9, 9}, s.Unwrap())
+	assert.Nil(t, st.UnmarshalBinary(data))
+	assert.Equal(t, []int{9, 7}, st.Unwrap())
+
+	var o struct {
+		S  SortedSlice[int]
+		St SortedSet[int]
+	}
+	assert.EqualError(t, json.Unmarshal([]byte(`{"S":[1]}`), &o), "sorted slice has no less function")
+	assert.EqualError(t, json.Unmarshal([]byte(`{"St":[1]}`), &o), "sorted set has no less function")
+	assert.EqualError(t, o.St.UnmarshalBinary(data), "sorted set has no less function")
+}
+
+func TestTupleJSON(t *testing.T) {
+	tp := Tuple[string, int]{"a", 1}
+
+	data, err := json.Marshal(tp)
+	assert.Nil(t, err)
+	assert.Equal(t, `["a",1]`, string(data))
+
+	data, err = json.Marshal(tp.AsObject())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"V1":"a","V2":1}`, string(data))
+
+	var r Tuple[string, int]
+	assert.Nil(t, json.Unmarshal([]byte(`["b", 2]`), &r))
+	assert.Equal(t, Tuple[string, int]{"b", 2}, r)
+
+	assert.Nil(t, json.Unmarshal([]byte(` {"V1":"c","V2":3}`), &r))
+	assert.Equal(t, Tuple[string, int]{"c", 3}, r)
+
+	assert.Error(t, json.Unmarshal([]byte(`["b", "c"]`), &r))
+	assert.Error(t, json.Unmarshal([]byte(`["b", 1, 2]`), &r))
+
+	data, err = json.Marshal(MapFlat(map[string]int{"a": 1}))
+	assert.Nil(t, err)
+	assert.Equal(t, `[["a",1]]`, string(data))
+}
+
+func TestTupleNJSON(t *testing.T) {
+	t3 := Tuple3[string, int, bool]{"a", 1, true}
+	data, err := json.Marshal(t3)
+	assert.Nil(t, err)
+	assert.Equal(t, `["a",1,true]`, string(data))
+
+	var r3 Tuple3[string, int, bool]
+	assert.Nil(t, json.Unmarshal(data, &r3))
+	assert.Equal(t, t3, r3)
+	assert.Nil(t, json.Unmarshal([]byte(`{"V1":"b","V2":2,"V3":false}`), &r3))
+	assert.Equal(t, Tuple3[string, int, bool]{"b", 2, false}, r3)
+	assert.Error(t, json.Unmarshal([]byte(`["a",1]`), &r3))
+
+	t4 := Tuple4[string, int, bool, float64]{"a", 1, true, 1.5}
+	data, err = json.Marshal(t4)
+	assert.Nil(t, err)
+	assert.Equal(t, `["a",1,true,1.5]`, string(data))
+
+	var r4 Tuple4[string, int, bool, float64]
+	assert.Nil(t, json.Unmarshal(data, &r4))
+	assert.Equal(t, t4, r4)
+	r4 = Tuple4[string, int, bool, float64]{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"V1":"b","V4":2.5}`), &r4))
+	assert.Equal(t, Tuple4[string, int, bool, float64]{"b", 0, false, 2.5}, r4)
+	assert.Error(t, json.Unmarshal([]byte(`["a",1,true,1.5,2]`), &r4))
+}
@@ -2,12 +2,17 @@ package sop
 
 import "math/rand"
 
-// Enumerable specifies a wrapped slice object
-// to perform different enumerable operations
-// on.
-type Enumerable[T any] interface {
+// ReadOnly specifies the non-mutating subset
+// of the operations of an Enumerable.
+//
+// It can be used to express that an Enumerable
+// is not modified by the receiver.
+type ReadOnly[T any] interface {
 	// Unwrap returns the originaly packed
 	// Enumerable []T of the Enumerable[T] object.
+	//
+	// Immutable implementations return a copy
+	// of their elements.
 	Unwrap() []T
 	// Len returns the length of the given Enumerable.
 	Len() int
@@ -57,10 +62,30 @@ type Enumerable[T any] interface {
 	Shuffle(rngSrc ...rand.Source) Enumerable[T]
 	// Sort re-orders the Enumerable x given the provided less function.
 	Sort(less func(p, q T, i int) bool) Enumerable[T]
+	// SortStable re-orders the Enumerable x given the provided
+	// less function while keeping the original order of
+	// equal elements.
+	//
+	// less is getting passed the index i of p in the
+	// original Enumerable.
+	SortStable(less func(p, q T, i int) bool) Enumerable[T]
 	// Aggregate applies tze multiplicator function f over
 	// all elements of the given Enumerable and returns the final
 	// result.
 	Aggregate(f func(a, b T) T) T
+	// At safely accesses the element in the Enumerable
+	// at the given index i and returns it, if existent.
+	// If there is no value at i, default of T and false
+	// is returned.
+	At(i int) (T, bool)
+}
+
+// Enumerable specifies a wrapped slice object
+// to perform different enumerable operations
+// on.
+type Enumerable[T any] interface {
+	ReadOnly[T]
+
 	// Push appends the passed value v to the Enumerable.
 	Push(v T)
 	// Pop removes the last element of the Enumerable and
@@ -76,14 +101,49 @@ type Enumerable[T any] interface {
 	// starting at i with the amount of n. The removed
 	// Enumerable is returned as new Enumerable.
 	Splice(i, n int) Enumerable[T]
-	// At safely accesses the element in the Enumerable
-	// at the given index i and returns it, if existent.
-	// If there is no value at i, default of T and false
-	// is returned.
-	At(i int) (T, bool)
 	// Replace safely replaces the value in the Enumerable
 	// at the given index i with the given value v and
 	// returns true if the value was replaced. If the
 	// Enumerable has no value at i, false is returned.
 	Replace(i int, v T) bool
 }
+
+// SetEnumerable specifies an Enumerable which
+// guarantees that each element is unique and
+// which provides set-specific operations.
+type SetEnumerable[T comparable] interface {
+	Enumerable[T]
+
+	// Contains returns true if the given
+	// element v is contained in the set.
+	Contains(v T) bool
+	// Union returns a new set containing all elements
+	// of the set followed by all elements of v which
+	// are not contained in the set.
+	Union(v Enumerable[T]) *SetOf[T]
+	// Intersect returns a new set containing all
+	// elements of the set which are also contained
+	// in v.
+	Intersect(v Enumerable[T]) *SetOf[T]
+	// Difference returns a new set containing all
+	// elements of the set which are not contained
+	// in v.
+	Difference(v Enumerable[T]) *SetOf[T]
+	// SymmetricDifference returns a new set containing
+	// all elements of the set which are not contained
+	// in v followed by all elements of v which are not
+	// contained in the set.
+	SymmetricDifference(v Enumerable[T]) *SetOf[T]
+	// IsSubsetOf returns true if all elements of
+	// the set are contained in v.
+	IsSubsetOf(v Enumerable[T]) bool
+	// IsSupersetOf returns true if all elements
+	// of v are contained in the set.
+	IsSupersetOf(v Enumerable[T]) bool
+	// IsDisjoint returns true if no element of v
+	// is contained in the set.
+	IsDisjoint(v Enumerable[T]) bool
+	// Equal returns true if the set and v contain
+	// the same elements, regardless of their order.
+	Equal(v Enumerable[T]) bool
+}
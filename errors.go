@@ -0,0 +1,295 @@
+package sop
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorMode specifies how error-returning
+// operations handle errors returned by the
+// passed callback.
+type ErrorMode int
+
+const (
+	// StopOnError stops the operation on the
+	// first error and returns it wrapped in an
+	// *ElementError.
+	StopOnError ErrorMode = iota
+	// CollectErrors continues the operation
+	// on errors and returns all of them as
+	// ElementErrors.
+	CollectErrors
+)
+
+// ElementError wraps an error returned by a
+// callback for the element at Index.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ElementErrors joins all errors returned
+// by a callback in CollectErrors mode.
+type ElementErrors []*ElementError
+
+func (e ElementErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Is returns true if any of the joined errors
+// matches target. It allows errors.Is to be used
+// on ElementErrors before Go 1.20.
+func (e ElementErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the joined errors which
+// matches target and sets target to it. It allows
+// errors.As to be used on ElementErrors before
+// Go 1.20.
+func (e ElementErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e ElementErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Indices returns the indices of all
+// failed elements.
+func (e ElementErrors) Indices() []int {
+	idx := make([]int, len(e))
+	for i, err := range e {
+		idx[i] = err.Index
+	}
+	return idx
+}
+
+// EachErr works like Each but f may return an
+// error. By default, the iteration stops on the
+// first error, which is then returned.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors.
+func EachErr[T any](s Enumerable[T], f func(v T, i int) error, mode ...ErrorMode) error {
+	notNil("f", f)
+	c := newErrCollector(mode)
+	s.Any(func(v T, i int) bool {
+		return c.add(i, f(v, i))
+	})
+	return c.err()
+}
+
+// FilterErr works like Filter but p may return an
+// error. By default, the iteration stops on the
+// first error, which is then returned.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors. Failed elements are then not
+// added to the result Slice.
+func FilterErr[T any](
+	s Enumerable[T],
+	p func(v T, i int) (bool, error),
+	mode ...ErrorMode,
+) (*SliceOf[T], error) {
+	notNil("p", p)
+	c := newErrCollector(mode)
+	res := newSliceFrom[T, T](s)
+	var j int
+	s.Any(func(v T, i int) bool {
+		ok, err := p(v, i)
+		if err != nil {
+			return c.add(i, err)
+		}
+		if ok {
+			res[j] = v
+			j++
+		}
+		return false
+	})
+	if err := c.err(); err != nil && !c.collect {
+		return nil, err
+	}
+	return Slice(res[:j]), c.err()
+}
+
+// MapErr works like Map but f may return an
+// error. By default, the iteration stops on the
+// first error, which is then returned.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors. Failed elements are then set
+// to the default of TOut in the result Slice.
+func MapErr[TIn, TOut any](
+	s Enumerable[TIn],
+	f func(v TIn, i int) (TOut, error),
+	mode ...ErrorMode,
+) (*SliceOf[TOut], error) {
+	notNil("f", f)
+	c := newErrCollector(mode)
+	res := newSliceFrom[TIn, TOut](s)
+	s.Any(func(v TIn, i int) bool {
+		r, err := f(v, i)
+		if err != nil {
+			return c.add(i, err)
+		}
+		res[i] = r
+		return false
+	})
+	if err := c.err(); err != nil && !c.collect {
+		return nil, err
+	}
+	return Slice(res), c.err()
+}
+
+// FillErr works like Fill but f may return an
+// error. By default, the iteration stops on the
+// first error, which is then returned.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors. Failed elements are then set
+// to the default of T in the result Slice.
+func FillErr[T any](n int, f func(i int) (T, error), mode ...ErrorMode) (*SliceOf[T], error) {
+	notNil("f", f)
+	c := newErrCollector(mode)
+	res := make([]T, n)
+	for i := 0; i < n; i++ {
+		v, err := f(i)
+		if err != nil {
+			if c.add(i, err) {
+				return nil, c.err()
+			}
+			continue
+		}
+		res[i] = v
+	}
+	return Slice(res), c.err()
+}
+
+// GroupErr works like Group but f may return an
+// error. By default, the iteration stops on the
+// first error, which is then returned.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors. Failed elements are then not
+// added to the result map.
+func GroupErr[TVal any, TMKey comparable, TMVal any](
+	v Enumerable[TVal],
+	f func(v TVal, i int) (TMKey, TMVal, error),
+	mode ...ErrorMode,
+) (map[TMKey]TMVal, error) {
+	notNil("f", f)
+	c := newErrCollector(mode)
+	res := make(map[TMKey]TMVal)
+	v.Any(func(v TVal, i int) bool {
+		mk, mv, err := f(v, i)
+		if err != nil {
+			return c.add(i, err)
+		}
+		res[mk] = mv
+		return false
+	})
+	if err := c.err(); err != nil && !c.collect {
+		return nil, err
+	}
+	return res, c.err()
+}
+
+// AggregateErr works like Aggregate but f may
+// return an error. By default, the iteration
+// stops on the first error, which is then
+// returned. The index of the error is the index
+// of the element b passed to f.
+//
+// You can pass CollectErrors as mode to continue
+// on errors and get all of them returned as
+// ElementErrors. Failed elements are then skipped
+// and the aggregation continues with the last
+// successful result.
+func AggregateErr[T any](s Enumerable[T], f func(a, b T) (T, error), mode ...ErrorMode) (T, error) {
+	notNil("f", f)
+	c := newErrCollector(mode)
+	var acc T
+	s.Any(func(v T, i int) bool {
+		if i == 0 {
+			acc = v
+			return false
+		}
+		r, err := f(acc, v)
+		if err != nil {
+			return c.add(i, err)
+		}
+		acc = r
+		return false
+	})
+	if err := c.err(); err != nil && !c.collect {
+		return *new(T), err
+	}
+	return acc, c.err()
+}
+
+// errCollector keeps track of errors returned
+// by callbacks depending on the ErrorMode.
+type errCollector struct {
+	collect bool
+	errs    ElementErrors
+}
+
+func newErrCollector(mode []ErrorMode) *errCollector {
+	return &errCollector{
+		collect: len(mode) != 0 && mode[0] == CollectErrors,
+	}
+}
+
+// add records err for the element at index i,
+// if err is not nil, and returns true if the
+// iteration should be stopped.
+func (c *errCollector) add(i int, err error) bool {
+	if err == nil {
+		return false
+	}
+	c.errs = append(c.errs, &ElementError{Index: i, Err: err})
+	return !c.collect
+}
+
+func (c *errCollector) err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	if !c.collect {
+		return c.errs[0]
+	}
+	return c.errs
+}
@@ -0,0 +1,160 @@
+package sop
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errTest = errors.New("test error")
+
+func failOdd(v int) error {
+	if v%2 != 0 {
+		return errTest
+	}
+	return nil
+}
+
+func TestElementErrors(t *testing.T) {
+	err := ElementErrors{
+		{Index: 1, Err: errTest},
+		{Index: 3, Err: errors.New("other")},
+	}
+	assert.Equal(t, "element 1: test error\nelement 3: other", err.Error())
+	assert.Equal(t, []int{1, 3}, err.Indices())
+	assert.ErrorIs(t, err, errTest)
+	assert.True(t, err.Is(errTest))
+	assert.False(t, err.Is(errors.New("test error")))
+
+	var elemErr *ElementError
+	assert.True(t, err.As(&elemErr))
+	assert.Equal(t, 1, elemErr.Index)
+}
+
+func TestEachErr(t *testing.T) {
+	w := Slice([]int{2, 4, 5, 6, 7})
+
+	var visited []int
+	err := EachErr[int](w, func(v, i int) error {
+		visited = append(visited, v)
+		return failOdd(v)
+	})
+	assert.Equal(t, []int{2, 4, 5}, visited)
+	var elemErr *ElementError
+	assert.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 2, elemErr.Index)
+	assert.ErrorIs(t, err, errTest)
+
+	visited = nil
+	err = EachErr[int](w, func(v, i int) error {
+		visited = append(visited, v)
+		return failOdd(v)
+	}, CollectErrors)
+	assert.Equal(t, []int{2, 4, 5, 6, 7}, visited)
+	var elemErrs ElementErrors
+	assert.ErrorAs(t, err, &elemErrs)
+	assert.Equal(t, []int{2, 4}, elemErrs.Indices())
+
+	err = EachErr[int](Slice([]int{2}), func(v, i int) error {
+		return failOdd(v)
+	}, CollectErrors)
+	assert.Nil(t, err)
+
+	assert.Panics(t, func() {
+		EachErr[int](Slice([]int{1}), nil)
+	})
+}
+
+func TestFilterErr(t *testing.T) {
+	w := Slice([]int{2, 3, 4, 5, 6})
+	p := func(v, i int) (bool, error) {
+		if v == 3 || v == 5 {
+			return false, errTest
+		}
+		return v > 2, nil
+	}
+
+	r, err := FilterErr[int](w, p)
+	assert.Nil(t, r)
+	assert.ErrorIs(t, err, errTest)
+
+	r, err = FilterErr[int](w, p, CollectErrors)
+	assert.Equal(t, []int{4, 6}, r.Unwrap())
+	assert.Equal(t, []int{1, 3}, err.(ElementErrors).Indices())
+
+	r, err = FilterErr[int](Slice([]int{1, 4}), p)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4}, r.Unwrap())
+}
+
+func TestMapErr(t *testing.T) {
+	w := Slice([]string{"1", "a", "3", "b"})
+
+	r, err := MapErr[string](w, func(v string, _ int) (int, error) {
+		return strconv.Atoi(v)
+	})
+	assert.Nil(t, r)
+	assert.Equal(t, 1, err.(*ElementError).Index)
+
+	r, err = MapErr[string](w, func(v string, _ int) (int, error) {
+		return strconv.Atoi(v)
+	}, CollectErrors)
+	assert.Equal(t, []int{1, 0, 3, 0}, r.Unwrap())
+	assert.Equal(t, []int{1, 3}, err.(ElementErrors).Indices())
+
+	r, err = MapErr[string](Slice([]string{"1", "2"}), func(v string, _ int) (int, error) {
+		return strconv.Atoi(v)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, r.Unwrap())
+}
+
+func TestFillErr(t *testing.T) {
+	f := func(i int) (int, error) {
+		return i, failOdd(i)
+	}
+
+	r, err := FillErr(4, f)
+	assert.Nil(t, r)
+	assert.Equal(t, 1, err.(*ElementError).Index)
+
+	r, err = FillErr(4, f, CollectErrors)
+	assert.Equal(t, []int{0, 0, 2, 0}, r.Unwrap())
+	assert.Equal(t, []int{1, 3}, err.(ElementErrors).Indices())
+}
+
+func TestGroupErr(t *testing.T) {
+	w := Slice([]int{1, 2, 3, 4})
+	f := func(v, _ int) (string, int, error) {
+		return strconv.Itoa(v), v, failOdd(v)
+	}
+
+	m, err := GroupErr[int](w, f)
+	assert.Nil(t, m)
+	assert.Equal(t, 0, err.(*ElementError).Index)
+
+	m, err = GroupErr[int](w, f, CollectErrors)
+	assert.Equal(t, map[string]int{"2": 2, "4": 4}, m)
+	assert.Equal(t, []int{0, 2}, err.(ElementErrors).Indices())
+}
+
+func TestAggregateErr(t *testing.T) {
+	w := Slice([]int{1, 2, 3, 4})
+	f := func(a, b int) (int, error) {
+		return a + b, failOdd(b)
+	}
+
+	r, err := AggregateErr[int](w, f)
+	assert.Equal(t, 0, r)
+	assert.Equal(t, 2, err.(*ElementError).Index)
+
+	r, err = AggregateErr[int](w, f, CollectErrors)
+	assert.Equal(t, 7, r)
+	assert.Equal(t, []int{2}, err.(ElementErrors).Indices())
+
+	r, err = AggregateErr[int](Slice([]int{}), f)
+	assert.Equal(t, 0, r)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,113 @@
+package sop
+
+import "container/heap"
+
+// Heap is a binary heap ordered by a less
+// function where the smallest element in terms
+// of less is always at the top. It can be used
+// as priority queue with O(log n) Push and Pop.
+//
+// The Enumerable operations iterate the elements
+// in heap order, which is not sorted.
+type Heap[T any] struct {
+	slice[T]
+	less func(p, q T, i int) bool
+}
+
+var _ Enumerable[any] = (*Heap[any])(nil)
+
+// NewHeap creates a new empty *Heap[T] ordered by
+// the given less function, like util.Asc or
+// util.Desc.
+func NewHeap[T any](less func(p, q T, i int) bool) *Heap[T] {
+	notNil("less", less)
+	return &Heap[T]{less: less}
+}
+
+// Heapify creates a new *Heap[T] ordered by the
+// given less function containing all elements of
+// the Enumerable e in O(n).
+func Heapify[T any](e Enumerable[T], less func(p, q T, i int) bool) (h *Heap[T]) {
+	h = NewHeap(less)
+	h.s = copySlice(e.Unwrap())
+	heap.Init(h.heap())
+	return
+}
+
+func (h *Heap[T]) heap() heap.Interface {
+	return &sliceInterface[T]{&h.slice, h.less}
+}
+
+// Push adds the passed value v to the Heap.
+func (h *Heap[T]) Push(v T) {
+	heap.Push(h.heap(), v)
+}
+
+// Pop removes the top element of the Heap and
+// returns its value. If the Heap is empty, the
+// default value of T is returned.
+func (h *Heap[T]) Pop() (res T) {
+	if h.Len() == 0 {
+		return
+	}
+	return heap.Pop(h.heap()).(T)
+}
+
+// Peek returns the top element of the Heap
+// without removing it and true. If the Heap
+// is empty, default of T and false is returned.
+func (h *Heap[T]) Peek() (T, bool) {
+	return h.At(0)
+}
+
+// Append adds all elements of Enumerable v
+// to the Heap.
+func (h *Heap[T]) Append(v Enumerable[T]) {
+	v.Each(func(e T, _ int) {
+		h.Push(e)
+	})
+}
+
+// Splice removes the values from the given Heap
+// starting at i with the amount of n in heap
+// order. The removed values are returned as new
+// Slice.
+func (h *Heap[T]) Splice(i, n int) (res Enumerable[T]) {
+	res = h.slice.Splice(i, n)
+	heap.Init(h.heap())
+	return
+}
+
+// Replace replaces the value in the Heap at the
+// given index i with the given value v and
+// re-establishes the heap order. If the Heap
+// has no value at i, false is returned.
+func (h *Heap[T]) Replace(i int, v T) (ok bool) {
+	return h.Update(i, v)
+}
+
+// Update works like Replace and can be used to
+// change the priority of an element.
+func (h *Heap[T]) Update(i int, v T) (ok bool) {
+	if ok = h.slice.Replace(i, v); ok {
+		heap.Fix(h.heap(), i)
+	}
+	return
+}
+
+// Fix re-establishes the heap order after the
+// element at index i has been changed in place.
+func (h *Heap[T]) Fix(i int) {
+	heap.Fix(h.heap(), i)
+}
+
+// Remove removes the element at index i from the
+// Heap and returns its value and true. If the Heap
+// has no value at i, default of T and false is
+// returned.
+func (h *Heap[T]) Remove(i int) (v T, ok bool) {
+	if i < 0 || i >= h.Len() {
+		return
+	}
+	return heap.Remove(h.heap(), i).(T), true
+}
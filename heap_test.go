@@ -0,0 +1,145 @@
+package sop
+
+import (
+	"container/heap"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zekrotja/sop/util"
+)
+
+func asc(p, q, _ int) bool {
+	return p < q
+}
+
+func drain(h *Heap[int]) (res []int) {
+	for h.Len() != 0 {
+		res = append(res, h.Pop())
+	}
+	return
+}
+
+func TestSliceInterface(t *testing.T) {
+	w := Slice([]int{3, 1, 2})
+	sort.Sort(SliceInterface(w, asc))
+	assert.Equal(t, []int{1, 2, 3}, w.Unwrap())
+
+	w = Slice([]int{5, 3, 4})
+	hi := SliceInterface(w, asc)
+	heap.Init(hi)
+	heap.Push(hi, 1)
+	assert.Equal(t, 1, heap.Pop(hi))
+	assert.Equal(t, 3, heap.Pop(hi))
+	assert.Equal(t, 2, w.Len())
+
+	assert.Panics(t, func() {
+		SliceInterface[int](w, nil)
+	})
+}
+
+func TestSetInterface(t *testing.T) {
+	s := Set([]int{3, 1, 2})
+	sort.Sort(SetInterface(s, asc))
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	s = Set([]int{5, 3, 4})
+	hi := SetInterface(s, util.Asc[int])
+	heap.Init(hi)
+	heap.Push(hi, 3)
+	assert.Equal(t, 3, s.Len())
+	heap.Push(hi, 1)
+	assert.Equal(t, 1, heap.Pop(hi))
+	assert.False(t, s.Contains(1))
+	assert.Equal(t, 3, heap.Pop(hi))
+	assert.False(t, s.Contains(3))
+	assert.ElementsMatch(t, []int{4, 5}, s.Unwrap())
+
+	s.Push(3)
+	assert.Equal(t, 3, s.Len())
+	assert.False(t, s.Replace(0, 5))
+	assert.True(t, s.Replace(0, 6))
+	assert.True(t, s.Contains(6))
+
+	assert.Panics(t, func() {
+		SetInterface[int](s, nil)
+	})
+}
+
+func TestHeap(t *testing.T) {
+	assertUnexposed(t, Heap[int]{})
+
+	h := NewHeap(util.Asc[int])
+	for _, v := range []int{5, 2, 8, 1, 9, 3} {
+		h.Push(v)
+	}
+	assert.Equal(t, 6, h.Len())
+
+	v, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, drain(h))
+	assert.Equal(t, 0, h.Pop())
+
+	_, ok = h.Peek()
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		NewHeap[int](nil)
+	})
+}
+
+func TestHeapify(t *testing.T) {
+	src := Slice([]int{4, 7, 1, 9, 3})
+	h := Heapify[int](src, util.Desc[int])
+	assert.Equal(t, []int{4, 7, 1, 9, 3}, src.Unwrap())
+	assert.Equal(t, []int{9, 7, 4, 3, 1}, drain(h))
+}
+
+func TestHeapUpdate(t *testing.T) {
+	h := Heapify[int](Slice([]int{5, 2, 8, 1}), asc)
+
+	_, i := h.First(func(v, _ int) bool {
+		return v == 8
+	})
+	assert.True(t, h.Update(i, 0))
+	v, _ := h.Peek()
+	assert.Equal(t, 0, v)
+	assert.False(t, h.Update(10, 0))
+
+	h.Unwrap()[0] = 6
+	h.Fix(0)
+	assert.Equal(t, []int{1, 2, 5, 6}, drain(h))
+}
+
+func TestHeapRemove(t *testing.T) {
+	h := Heapify[int](Slice([]int{5, 2, 8, 1}), asc)
+
+	_, i := h.First(func(v, _ int) bool {
+		return v == 2
+	})
+	v, ok := h.Remove(i)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = h.Remove(-1)
+	assert.False(t, ok)
+
+	assert.Equal(t, []int{1, 5, 8}, drain(h))
+}
+
+func TestHeapEnumerable(t *testing.T) {
+	var e Enumerable[int] = Heapify[int](Slice([]int{5, 2, 8}), asc)
+	e.Append(Slice([]int{7, 0}))
+	assert.Equal(t, 5, e.Len())
+	assert.Equal(t, 0, e.Pop())
+	assert.Equal(t, []int{2, 5, 7, 8}, e.Sort(asc).Unwrap())
+
+	assert.True(t, e.Replace(0, 9))
+	assert.Equal(t, 5, e.Pop())
+
+	e.Splice(0, 1)
+	assert.Equal(t, 2, e.Len())
+	assert.Equal(t, []int{8, 9}, drain(e.(*Heap[int])))
+}
@@ -0,0 +1,207 @@
+package sop
+
+import "math/rand"
+
+// ImmutableSlice wraps a native slice which
+// is never mutated. All operations which would
+// modify the slice return a new ImmutableSlice
+// instead, so values can safely be shared across
+// goroutines.
+//
+// Operations which only shrink the slice, like
+// Pop or Splice at the start or end, share the
+// backing array with the original value.
+type ImmutableSlice[T any] struct {
+	s []T
+}
+
+var _ ReadOnly[any] = ImmutableSlice[any]{}
+
+// Immutable packs a copy of the given
+// slice []T into an ImmutableSlice[T].
+func Immutable[T any](s []T) ImmutableSlice[T] {
+	return ImmutableSlice[T]{copySlice(s)}
+}
+
+func (s ImmutableSlice[T]) view() *SliceOf[T] {
+	return Slice(s.s)
+}
+
+// Unwrap returns a copy of the elements
+// of the ImmutableSlice as slice []T.
+func (s ImmutableSlice[T]) Unwrap() []T {
+	return copySlice(s.s)
+}
+
+// Mutable returns a copy of the elements
+// of the ImmutableSlice as Enumerable[T].
+func (s ImmutableSlice[T]) Mutable() *SliceOf[T] {
+	return Slice(s.Unwrap())
+}
+
+// Len returns the length of the given ImmutableSlice.
+func (s ImmutableSlice[T]) Len() int {
+	return len(s.s)
+}
+
+// Each performs the given function f on each
+// element in the ImmutableSlice.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func (s ImmutableSlice[T]) Each(f func(v T, i int)) {
+	s.view().Each(f)
+}
+
+// Filter performs preticate p on each element
+// in the ImmutableSlice and each element where p
+// returns true will be added to the result
+// Enumerable.
+func (s ImmutableSlice[T]) Filter(p func(v T, i int) bool) Enumerable[T] {
+	return s.view().Filter(p)
+}
+
+// Any returns true when at least one element in
+// the given ImmutableSlice result in a true return
+// of p when performed on p.
+func (s ImmutableSlice[T]) Any(p func(v T, i int) bool) bool {
+	return s.view().Any(p)
+}
+
+// All returns true when all elements in the given
+// ImmutableSlice result in a true return of p when
+// performed on p.
+func (s ImmutableSlice[T]) All(p func(v T, i int) bool) bool {
+	return s.view().All(p)
+}
+
+// None returns true when no element in the given
+// ImmutableSlice results in a true return of p
+// when performed on p.
+func (s ImmutableSlice[T]) None(p func(v T, i int) bool) bool {
+	return s.view().None(p)
+}
+
+// First returns the value and index of the first
+// occurence in the ImmutableSlice where preticate
+// p returns true.
+//
+// If this applies to no element in the ImmutableSlice,
+// default of T and -1 is returned.
+func (s ImmutableSlice[T]) First(p func(v T, i int) bool) (T, int) {
+	return s.view().First(p)
+}
+
+// Count returns the number of elements in the given
+// ImmutableSlice which, when applied on p, return true.
+func (s ImmutableSlice[T]) Count(p func(v T, i int) bool) int {
+	return s.view().Count(p)
+}
+
+// Shuffle returns the elements of the given
+// ImmutableSlice in a pseudo-random order as
+// new Enumerable.
+//
+// You can also pass a custom random source rngSrc if
+// you desire.
+func (s ImmutableSlice[T]) Shuffle(rngSrc ...rand.Source) Enumerable[T] {
+	return s.view().Shuffle(rngSrc...)
+}
+
+// Sort returns the elements of the ImmutableSlice
+// ordered by the provided less function as new
+// Enumerable.
+func (s ImmutableSlice[T]) Sort(less func(p, q T, i int) bool) Enumerable[T] {
+	return s.view().Sort(less)
+}
+
+// SortStable returns the elements of the ImmutableSlice
+// ordered by the provided less function as new Enumerable
+// while keeping the original order of equal elements.
+func (s ImmutableSlice[T]) SortStable(less func(p, q T, i int) bool) Enumerable[T] {
+	return s.view().SortStable(less)
+}
+
+// Aggregate applies the multiplicator function f over
+// all elements of the given ImmutableSlice and returns
+// the final result.
+func (s ImmutableSlice[T]) Aggregate(f func(a, b T) T) T {
+	return s.view().Aggregate(f)
+}
+
+// At safely accesses the element in the ImmutableSlice
+// at the given index i and returns it, if existent.
+// If there is no value at i, default of T and false
+// is returned.
+func (s ImmutableSlice[T]) At(i int) (T, bool) {
+	return s.view().At(i)
+}
+
+// Push returns a new ImmutableSlice with the
+// passed value v appended.
+func (s ImmutableSlice[T]) Push(v T) ImmutableSlice[T] {
+	res := make([]T, len(s.s), len(s.s)+1)
+	copy(res, s.s)
+	return ImmutableSlice[T]{append(res, v)}
+}
+
+// Pop returns a new ImmutableSlice without the
+// last element and the value of the removed
+// element. If the ImmutableSlice is empty, it
+// is returned together with the default value
+// of T.
+func (s ImmutableSlice[T]) Pop() (ImmutableSlice[T], T) {
+	if len(s.s) == 0 {
+		return s, *new(T)
+	}
+	n := len(s.s) - 1
+	return ImmutableSlice[T]{s.s[:n:n]}, s.s[n]
+}
+
+// Append returns a new ImmutableSlice with all
+// elements of v appended.
+func (s ImmutableSlice[T]) Append(v ReadOnly[T]) ImmutableSlice[T] {
+	res := make([]T, len(s.s), len(s.s)+v.Len())
+	copy(res, s.s)
+	v.Each(func(e T, _ int) {
+		res = append(res, e)
+	})
+	return ImmutableSlice[T]{res}
+}
+
+// Flush returns an empty ImmutableSlice.
+func (s ImmutableSlice[T]) Flush() ImmutableSlice[T] {
+	return ImmutableSlice[T]{}
+}
+
+// Splice returns a new ImmutableSlice without the
+// values starting at i with the amount of n and
+// the removed values as ImmutableSlice.
+func (s ImmutableSlice[T]) Splice(i, n int) (rest, removed ImmutableSlice[T]) {
+	removed = ImmutableSlice[T]{s.s[i : i+n : i+n]}
+	switch {
+	case i == 0:
+		rest = ImmutableSlice[T]{s.s[n:]}
+	case i+n == len(s.s):
+		rest = ImmutableSlice[T]{s.s[:i:i]}
+	default:
+		res := make([]T, 0, len(s.s)-n)
+		res = append(res, s.s[:i]...)
+		rest = ImmutableSlice[T]{append(res, s.s[i+n:]...)}
+	}
+	return
+}
+
+// Replace returns a new ImmutableSlice where the
+// value at index i is replaced with the given
+// value v and true. If the ImmutableSlice has no
+// value at i, it is returned unchanged and false.
+func (s ImmutableSlice[T]) Replace(i int, v T) (ImmutableSlice[T], bool) {
+	if i < 0 || i >= len(s.s) {
+		return s, false
+	}
+	res := copySlice(s.s)
+	res[i] = v
+	return ImmutableSlice[T]{res}, true
+}
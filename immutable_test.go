@@ -0,0 +1,134 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmutable(t *testing.T) {
+	src := []int{1, 2, 3}
+	s := Immutable(src)
+	src[0] = 5
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	u := s.Unwrap()
+	u[0] = 5
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	m := s.Mutable()
+	m.Push(4)
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	var zero ImmutableSlice[int]
+	assert.Equal(t, 0, zero.Len())
+	assert.Equal(t, []int{1}, zero.Push(1).Unwrap())
+}
+
+func TestImmutableReadOnly(t *testing.T) {
+	var r ReadOnly[int] = Immutable([]int{3, 1, 2})
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, []int{2}, r.Filter(even).Unwrap())
+	assert.True(t, r.Any(even))
+	assert.False(t, r.All(even))
+	assert.False(t, r.None(even))
+	assert.Equal(t, 1, r.Count(even))
+	assert.Equal(t, 6, r.Aggregate(func(a, b int) int {
+		return a + b
+	}))
+
+	v, i := r.First(even)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, i)
+
+	v, ok := r.At(1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	srt := r.Sort(func(p, q, _ int) bool {
+		return p < q
+	})
+	assert.Equal(t, []int{1, 2, 3}, srt.Unwrap())
+	srt.Replace(0, 9)
+	assert.Equal(t, []int{3, 1, 2}, r.Unwrap())
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, r.Shuffle().Unwrap())
+
+	var e ReadOnly[int] = Slice([]int{1})
+	assert.Equal(t, 1, e.Len())
+}
+
+func TestImmutablePushPop(t *testing.T) {
+	a := Immutable([]int{1, 2})
+	b := a.Push(3)
+	c := a.Push(4)
+	assert.Equal(t, []int{1, 2}, a.Unwrap())
+	assert.Equal(t, []int{1, 2, 3}, b.Unwrap())
+	assert.Equal(t, []int{1, 2, 4}, c.Unwrap())
+
+	d, v := b.Pop()
+	assert.Equal(t, 3, v)
+	assert.Equal(t, []int{1, 2}, d.Unwrap())
+	assert.Equal(t, []int{1, 2, 3}, b.Unwrap())
+
+	e := d.Push(5)
+	assert.Equal(t, []int{1, 2, 3}, b.Unwrap())
+	assert.Equal(t, []int{1, 2, 5}, e.Unwrap())
+
+	f, v := ImmutableSlice[int]{}.Pop()
+	assert.Equal(t, 0, v)
+	assert.Equal(t, 0, f.Len())
+}
+
+func TestImmutableAppend(t *testing.T) {
+	a := Immutable([]int{1, 2})
+	b := a.Append(Slice([]int{3, 4}))
+	c := b.Append(a)
+	assert.Equal(t, []int{1, 2}, a.Unwrap())
+	assert.Equal(t, []int{1, 2, 3, 4}, b.Unwrap())
+	assert.Equal(t, []int{1, 2, 3, 4, 1, 2}, c.Unwrap())
+	assert.Equal(t, 0, c.Flush().Len())
+	assert.Equal(t, 6, c.Len())
+}
+
+func TestImmutableSplice(t *testing.T) {
+	a := Immutable([]int{1, 2, 3, 4, 5})
+
+	rest, removed := a.Splice(1, 2)
+	assert.Equal(t, []int{1, 4, 5}, rest.Unwrap())
+	assert.Equal(t, []int{2, 3}, removed.Unwrap())
+
+	rest, removed = a.Splice(0, 2)
+	assert.Equal(t, []int{3, 4, 5}, rest.Unwrap())
+	assert.Equal(t, []int{1, 2}, removed.Unwrap())
+
+	rest, removed = a.Splice(3, 2)
+	assert.Equal(t, []int{1, 2, 3}, rest.Unwrap())
+	assert.Equal(t, []int{4, 5}, removed.Unwrap())
+
+	assert.Equal(t, []int{1, 2, 3, 9}, rest.Push(9).Unwrap())
+	assert.Equal(t, []int{4, 5, 9}, removed.Push(9).Unwrap())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, a.Unwrap())
+}
+
+func TestImmutableReplace(t *testing.T) {
+	a := Immutable([]int{1, 2, 3})
+
+	b, ok := a.Replace(1, 5)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 5, 3}, b.Unwrap())
+	assert.Equal(t, []int{1, 2, 3}, a.Unwrap())
+
+	b, ok = a.Replace(3, 5)
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 2, 3}, b.Unwrap())
+}
+
+func TestImmutableLazy(t *testing.T) {
+	q := Lazy[int](Immutable([]int{1, 2, 3})).Skip(1)
+	assert.Equal(t, []int{2, 3}, q.Unwrap())
+}
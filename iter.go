@@ -0,0 +1,148 @@
+//go:build go1.23
+
+package sop
+
+import "iter"
+
+// Seq returns an iterator over all
+// elements of the Slice.
+func (s *slice[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over all
+// indices and elements of the Slice.
+func (s *slice[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s.s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq returns an iterator over all
+// elements of the ImmutableSlice.
+func (s ImmutableSlice[T]) Seq() iter.Seq[T] {
+	return s.view().Seq()
+}
+
+// Seq2 returns an iterator over all indices
+// and elements of the ImmutableSlice.
+func (s ImmutableSlice[T]) Seq2() iter.Seq2[int, T] {
+	return s.view().Seq2()
+}
+
+// Seq returns an iterator over all elements
+// of a snapshot of the Concurrent, so that
+// no lock is held while iterating.
+func (c *Concurrent[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		c.Snapshot().Seq()(yield)
+	}
+}
+
+// Seq2 returns an iterator over all indices
+// and elements of a snapshot of the Concurrent,
+// so that no lock is held while iterating.
+func (c *Concurrent[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.Snapshot().Seq2()(yield)
+	}
+}
+
+// Seq returns an iterator over all
+// elements of the buffer in order.
+func (r *ring[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.n; i++ {
+			if !yield(r.buf[r.idx(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over all indices
+// and elements of the buffer in order.
+func (r *ring[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < r.n; i++ {
+			if !yield(i, r.buf[r.idx(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Seq returns an iterator evaluating
+// the Query lazily while iterating.
+func (q *Query[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		next := q.iter()
+		for v, ok := next(); ok; v, ok = next() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator evaluating
+// the Query lazily while iterating and
+// yielding the index and value of each
+// resulting element.
+func (q *Query[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		next := q.iter()
+		var i int
+		for v, ok := next(); ok; v, ok = next() {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Seq2 returns an iterator over all keys
+// and values of the Dict in insertion order.
+func (d *Dict[K, V]) Seq2() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range d.entries {
+			if !yield(e.V1, e.V2) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates an Enumerable[T] from all
+// elements yielded by the iterator seq.
+func FromSeq[T any](seq iter.Seq[T]) *SliceOf[T] {
+	var res []T
+	seq(func(v T) bool {
+		res = append(res, v)
+		return true
+	})
+	return Slice(res)
+}
+
+// FromSeq2 creates an Enumerable containing
+// key-value Tuples from all pairs yielded by
+// the iterator seq.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) *SliceOf[Tuple[K, V]] {
+	var res []Tuple[K, V]
+	seq(func(k K, v V) bool {
+		res = append(res, Tuple[K, V]{k, v})
+		return true
+	})
+	return Slice(res)
+}
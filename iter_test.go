@@ -0,0 +1,116 @@
+//go:build go1.23
+
+package sop
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceSeq(t *testing.T) {
+	w := Slice([]int{1, 2, 3, 4})
+
+	var res []int
+	for v := range w.Seq() {
+		if v == 3 {
+			break
+		}
+		res = append(res, v)
+	}
+	assert.Equal(t, []int{1, 2}, res)
+
+	m := map[int]int{}
+	for i, v := range w.Seq2() {
+		m[i] = v
+	}
+	assert.Equal(t, map[int]int{0: 1, 1: 2, 2: 3, 3: 4}, m)
+
+	assert.Equal(t, []int{2, 3, 1}, slices.Collect(Set([]int{2, 3, 2, 1}).Seq()))
+}
+
+func TestCollectionSeq(t *testing.T) {
+	im := Immutable([]int{1, 2, 3})
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(im.Seq()))
+	assert.Equal(t, map[int]int{0: 1, 1: 2, 2: 3}, maps.Collect(im.Seq2()))
+
+	c := NewConcurrent[int](Slice([]int{1, 2, 3}))
+	for v := range c.Seq() {
+		c.Push(v)
+	}
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3}, c.Unwrap())
+	assert.Equal(t, 6, len(maps.Collect(c.Seq2())))
+
+	d := NewDeque(2, 3)
+	d.PushFront(1)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(d.Seq()))
+	assert.Equal(t, map[int]int{0: 1, 1: 2, 2: 3}, maps.Collect(d.Seq2()))
+
+	r := NewRingBuffer[int](2)
+	r.Append(Range(1, 3))
+	var res []int
+	for v := range r.Seq() {
+		res = append(res, v)
+		break
+	}
+	assert.Equal(t, []int{2}, res)
+	assert.Equal(t, map[int]int{0: 2, 1: 3}, maps.Collect(r.Seq2()))
+}
+
+func TestQuerySeq(t *testing.T) {
+	var calls int
+	q := Lazy[int](Range(0, 10)).Filter(func(v, _ int) bool {
+		calls++
+		return v%2 == 1
+	})
+
+	var res []int
+	for v := range q.Seq() {
+		res = append(res, v)
+		if len(res) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 3}, res)
+	assert.Equal(t, 4, calls)
+
+	m := map[int]int{}
+	for i, v := range q.Seq2() {
+		m[i] = v
+	}
+	assert.Equal(t, map[int]int{0: 1, 1: 3, 2: 5, 3: 7, 4: 9}, m)
+}
+
+func TestDictSeq2(t *testing.T) {
+	d := NewDict[string, int]()
+	d.Set("b", 1)
+	d.Set("a", 2)
+
+	var keys []string
+	for k, v := range d.Seq2() {
+		keys = append(keys, k)
+		assert.Equal(t, d.GetOr(k, 0), v)
+	}
+	assert.Equal(t, []string{"b", "a"}, keys)
+}
+
+func TestFromSeq(t *testing.T) {
+	r := FromSeq(slices.Values([]int{1, 2, 3}))
+	assert.Equal(t, []int{1, 2, 3}, r.Unwrap())
+
+	r = FromSeq(slices.Values([]int{}))
+	assert.Equal(t, 0, r.Len())
+
+	k := FromSeq(maps.Keys(map[string]int{"a": 1, "b": 2}))
+	assert.ElementsMatch(t, []string{"a", "b"}, k.Unwrap())
+}
+
+func TestFromSeq2(t *testing.T) {
+	r := FromSeq2(slices.All([]string{"a", "b"}))
+	assert.Equal(t, []Tuple[int, string]{{0, "a"}, {1, "b"}}, r.Unwrap())
+
+	m := FromSeq2(maps.All(map[string]int{"a": 1, "b": 2}))
+	assert.ElementsMatch(t, []Tuple[string, int]{{"a", 1}, {"b", 2}}, m.Unwrap())
+}
@@ -0,0 +1,131 @@
+package sop
+
+// Join correlates the elements of the Enumerables
+// outer and inner based on equal keys returned by
+// outerKey and innerKey. For each matching pair,
+// the return value of result is added to the
+// result Enumerable in the order of outer and,
+// for multiple matches, in the order of inner.
+//
+// Pass NewTuple as result to get Tuples of the
+// matching pairs.
+func Join[O, I any, K comparable, R any](
+	outer Enumerable[O],
+	inner Enumerable[I],
+	outerKey func(v O, i int) K,
+	innerKey func(v I, i int) K,
+	result func(vo O, vi I) R,
+) *SliceOf[R] {
+	notNil("result", result)
+	res := Slice[R](nil)
+	groupJoin(outer, inner, outerKey, innerKey, func(vo O, vis []I, _ []int) {
+		for _, vi := range vis {
+			res.Push(result(vo, vi))
+		}
+	})
+	return res
+}
+
+// LeftJoin works like Join but also adds a result
+// for elements of outer without any match in inner.
+// Then, result is getting passed default of I and
+// false for ok.
+func LeftJoin[O, I any, K comparable, R any](
+	outer Enumerable[O],
+	inner Enumerable[I],
+	outerKey func(v O, i int) K,
+	innerKey func(v I, i int) K,
+	result func(vo O, vi I, ok bool) R,
+) *SliceOf[R] {
+	notNil("result", result)
+	res := Slice[R](nil)
+	groupJoin(outer, inner, outerKey, innerKey, func(vo O, vis []I, _ []int) {
+		if len(vis) == 0 {
+			res.Push(result(vo, *new(I), false))
+		}
+		for _, vi := range vis {
+			res.Push(result(vo, vi, true))
+		}
+	})
+	return res
+}
+
+// FullOuterJoin works like LeftJoin but also adds
+// a result for elements of inner without any match
+// in outer after all results of outer. Then, result
+// is getting passed default of O and false for okO.
+func FullOuterJoin[O, I any, K comparable, R any](
+	outer Enumerable[O],
+	inner Enumerable[I],
+	outerKey func(v O, i int) K,
+	innerKey func(v I, i int) K,
+	result func(vo O, vi I, okO, okI bool) R,
+) *SliceOf[R] {
+	notNil("result", result)
+	res := Slice[R](nil)
+	matched := make([]bool, inner.Len())
+	groupJoin(outer, inner, outerKey, innerKey, func(vo O, vis []I, idx []int) {
+		if len(vis) == 0 {
+			res.Push(result(vo, *new(I), true, false))
+		}
+		for j, vi := range vis {
+			matched[idx[j]] = true
+			res.Push(result(vo, vi, true, true))
+		}
+	})
+	inner.Each(func(vi I, i int) {
+		if !matched[i] {
+			res.Push(result(*new(O), vi, false, true))
+		}
+	})
+	return res
+}
+
+// GroupJoin correlates each element of outer with
+// all elements of inner with an equal key and adds
+// the return value of result to the result
+// Enumerable in the order of outer. If there are
+// no matches, result is getting passed an empty
+// Enumerable.
+func GroupJoin[O, I any, K comparable, R any](
+	outer Enumerable[O],
+	inner Enumerable[I],
+	outerKey func(v O, i int) K,
+	innerKey func(v I, i int) K,
+	result func(vo O, vis Enumerable[I]) R,
+) *SliceOf[R] {
+	notNil("result", result)
+	res := make([]R, 0, outer.Len())
+	groupJoin(outer, inner, outerKey, innerKey, func(vo O, vis []I, _ []int) {
+		res = append(res, result(vo, Slice(vis)))
+	})
+	return Slice(res)
+}
+
+// groupJoin builds a hash index of inner and calls
+// f for each element of outer with all matching
+// elements of inner and their indices in inner.
+func groupJoin[O, I any, K comparable](
+	outer Enumerable[O],
+	inner Enumerable[I],
+	outerKey func(v O, i int) K,
+	innerKey func(v I, i int) K,
+	f func(vo O, vis []I, idx []int),
+) {
+	notNil("outerKey", outerKey)
+	notNil("innerKey", innerKey)
+	lookup := make(map[K][]int)
+	src := inner.Unwrap()
+	for i, vi := range src {
+		k := innerKey(vi, i)
+		lookup[k] = append(lookup[k], i)
+	}
+	outer.Each(func(vo O, i int) {
+		idx := lookup[outerKey(vo, i)]
+		vis := make([]I, len(idx))
+		for j, ii := range idx {
+			vis[j] = src[ii]
+		}
+		f(vo, vis, idx)
+	})
+}
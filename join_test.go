@@ -0,0 +1,99 @@
+package sop
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	UserID int
+	Item   string
+}
+
+var (
+	joinUsers = Slice([]joinUser{
+		{1, "alice"},
+		{2, "bob"},
+		{3, "carol"},
+	})
+	joinOrders = Slice([]joinOrder{
+		{3, "pen"},
+		{1, "book"},
+		{4, "lamp"},
+		{1, "cup"},
+	})
+)
+
+func userID(v joinUser, _ int) int {
+	return v.ID
+}
+
+func orderUserID(v joinOrder, _ int) int {
+	return v.UserID
+}
+
+func TestJoin(t *testing.T) {
+	r := Join[joinUser, joinOrder](joinUsers, joinOrders, userID, orderUserID,
+		func(u joinUser, o joinOrder) string {
+			return u.Name + ":" + o.Item
+		})
+	assert.Equal(t, []string{"alice:book", "alice:cup", "carol:pen"}, r.Unwrap())
+
+	rt := Join[joinUser, joinOrder](joinUsers, joinOrders, userID, orderUserID,
+		NewTuple[joinUser, joinOrder])
+	assert.Equal(t, []Tuple[joinUser, joinOrder]{
+		{joinUser{1, "alice"}, joinOrder{1, "book"}},
+		{joinUser{1, "alice"}, joinOrder{1, "cup"}},
+		{joinUser{3, "carol"}, joinOrder{3, "pen"}},
+	}, rt.Unwrap())
+
+	assert.Panics(t, func() {
+		Join[joinUser, joinOrder, int, int](joinUsers, joinOrders, userID, orderUserID, nil)
+	})
+	assert.Panics(t, func() {
+		Join[joinUser, joinOrder](joinUsers, joinOrders, nil, orderUserID,
+			NewTuple[joinUser, joinOrder])
+	})
+}
+
+func TestLeftJoin(t *testing.T) {
+	r := LeftJoin[joinUser, joinOrder](joinUsers, joinOrders, userID, orderUserID,
+		func(u joinUser, o joinOrder, ok bool) string {
+			return fmt.Sprintf("%s:%s:%t", u.Name, o.Item, ok)
+		})
+	assert.Equal(t, []string{
+		"alice:book:true",
+		"alice:cup:true",
+		"bob::false",
+		"carol:pen:true",
+	}, r.Unwrap())
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	r := FullOuterJoin[joinUser, joinOrder](joinUsers, joinOrders, userID, orderUserID,
+		func(u joinUser, o joinOrder, okU, okO bool) string {
+			return fmt.Sprintf("%s:%s:%t:%t", u.Name, o.Item, okU, okO)
+		})
+	assert.Equal(t, []string{
+		"alice:book:true:true",
+		"alice:cup:true:true",
+		"bob::true:false",
+		"carol:pen:true:true",
+		":lamp:false:true",
+	}, r.Unwrap())
+}
+
+func TestGroupJoin(t *testing.T) {
+	r := GroupJoin[joinUser, joinOrder](joinUsers, joinOrders, userID, orderUserID,
+		func(u joinUser, os Enumerable[joinOrder]) string {
+			return fmt.Sprintf("%s:%d", u.Name, os.Len())
+		})
+	assert.Equal(t, []string{"alice:2", "bob:0", "carol:1"}, r.Unwrap())
+}
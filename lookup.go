@@ -0,0 +1,147 @@
+package sop
+
+// Grouping is a group of values which share
+// the same Key.
+type Grouping[K comparable, V any] struct {
+	Key    K
+	Values *SliceOf[V]
+}
+
+// Lookup maps keys to groups of values. Keys
+// are kept in the order they were first seen.
+//
+// The zero value of Lookup is an empty Lookup
+// ready to use.
+type Lookup[K comparable, V any] struct {
+	groups Dict[K, *SliceOf[V]]
+}
+
+// GroupBy iterates through all elements of
+// Enumerable e and adds the value returned by
+// function f to the group of the key returned
+// by f. Unlike Group and GroupE, the order of
+// the keys is preserved.
+func GroupBy[T any, K comparable, V any](
+	e Enumerable[T],
+	f func(v T, i int) (K, V),
+) (res *Lookup[K, V]) {
+	notNil("f", f)
+	res = &Lookup[K, V]{}
+	e.Each(func(v T, i int) {
+		res.add(f(v, i))
+	})
+	return
+}
+
+func (l *Lookup[K, V]) add(k K, v V) {
+	if g, ok := l.groups.Get(k); ok {
+		g.Push(v)
+		return
+	}
+	l.groups.Set(k, Slice([]V{v}))
+}
+
+// Len returns the number of groups
+// in the Lookup.
+func (l *Lookup[K, V]) Len() int {
+	return l.groups.Len()
+}
+
+// Get returns the values of the group with
+// the key k as new Slice. If k is not contained
+// in the Lookup, an empty Slice is returned.
+func (l *Lookup[K, V]) Get(k K) *SliceOf[V] {
+	if g, ok := l.groups.Get(k); ok {
+		return Slice(copySlice(g.s))
+	}
+	return Slice([]V{})
+}
+
+// Count returns the number of values in the
+// group with the key k.
+func (l *Lookup[K, V]) Count(k K) int {
+	if g, ok := l.groups.Get(k); ok {
+		return g.Len()
+	}
+	return 0
+}
+
+// Contains returns true if a group with the
+// key k is contained in the Lookup.
+func (l *Lookup[K, V]) Contains(k K) bool {
+	return l.groups.Has(k)
+}
+
+// Keys returns the keys of all groups in
+// the order they were first seen.
+func (l *Lookup[K, V]) Keys() *SliceOf[K] {
+	return l.groups.Keys()
+}
+
+// Groups returns all groups of the Lookup
+// in the order their keys were first seen.
+func (l *Lookup[K, V]) Groups() *SliceOf[Grouping[K, V]] {
+	res := make([]Grouping[K, V], 0, l.Len())
+	l.Each(func(k K, vs *SliceOf[V], _ int) {
+		res = append(res, Grouping[K, V]{k, vs})
+	})
+	return Slice(res)
+}
+
+// Each performs the given function f on each
+// group in the Lookup in the order their keys
+// were first seen.
+//
+// f is getting passed the key k and a copy of
+// the values vs of the current group as well
+// as the current index i.
+func (l *Lookup[K, V]) Each(f func(k K, vs *SliceOf[V], i int)) {
+	notNil("f", f)
+	l.groups.Each(func(k K, vs *SliceOf[V], i int) {
+		f(k, Slice(copySlice(vs.s)), i)
+	})
+}
+
+// ToMap returns the groups of the Lookup as
+// native map like returned by GroupE.
+func (l *Lookup[K, V]) ToMap() (m map[K]Enumerable[V]) {
+	m = make(map[K]Enumerable[V], l.Len())
+	l.Each(func(k K, vs *SliceOf[V], _ int) {
+		m[k] = vs
+	})
+	return
+}
+
+// MapGroups creates a new Dict with the keys of
+// the Lookup l where the values are the results
+// of function f performed on each group.
+//
+// f is getting passed the key k and the values
+// vs of the current group as well as the current
+// index i.
+func MapGroups[K comparable, V, R any](
+	l *Lookup[K, V],
+	f func(k K, vs *SliceOf[V], i int) R,
+) (res *Dict[K, R]) {
+	notNil("f", f)
+	res = NewDict[K, R]()
+	l.Each(func(k K, vs *SliceOf[V], i int) {
+		res.Set(k, f(k, vs, i))
+	})
+	return
+}
+
+// ThenGroupBy groups the values of each group of
+// the Lookup l again by the keys returned by f
+// and returns the sub-Lookups by the keys of l.
+// This can be repeated using MapValues to group
+// by further levels.
+func ThenGroupBy[K comparable, V any, K2 comparable, V2 any](
+	l *Lookup[K, V],
+	f func(v V, i int) (K2, V2),
+) *Dict[K, *Lookup[K2, V2]] {
+	notNil("f", f)
+	return MapGroups(l, func(_ K, vs *SliceOf[V], _ int) *Lookup[K2, V2] {
+		return GroupBy[V](vs, f)
+	})
+}
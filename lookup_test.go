@@ -0,0 +1,98 @@
+package sop
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lookupObj struct {
+	team, role, name string
+}
+
+var lookupObjs = Slice([]lookupObj{
+	{"b", "dev", "anna"},
+	{"a", "ops", "bert"},
+	{"b", "ops", "carl"},
+	{"b", "dev", "dora"},
+	{"a", "ops", "emil"},
+})
+
+func byTeam(v lookupObj, _ int) (string, lookupObj) {
+	return v.team, v
+}
+
+func TestGroupBy(t *testing.T) {
+	l := GroupBy[int](Slice([]int{3, 1, 4, 6, 5, 9}), func(v, _ int) (bool, int) {
+		return v%2 == 0, v
+	})
+	assert.Equal(t, 2, l.Len())
+	assert.Equal(t, []bool{false, true}, l.Keys().Unwrap())
+	assert.Equal(t, []int{3, 1, 5, 9}, l.Get(false).Unwrap())
+	assert.Equal(t, []int{4, 6}, l.Get(true).Unwrap())
+
+	assert.Panics(t, func() {
+		GroupBy[int, int, int](Slice([]int{1}), nil)
+	})
+}
+
+func TestLookupZero(t *testing.T) {
+	var l Lookup[string, int]
+	assert.Equal(t, 0, l.Len())
+	assert.False(t, l.Contains("a"))
+	assert.Equal(t, 0, l.Count("a"))
+	assert.Equal(t, []int{}, l.Get("a").Unwrap())
+	assert.Equal(t, []Grouping[string, int]{}, l.Groups().Unwrap())
+}
+
+func TestLookup(t *testing.T) {
+	l := GroupBy[lookupObj](lookupObjs, byTeam)
+
+	assert.True(t, l.Contains("a"))
+	assert.False(t, l.Contains("c"))
+	assert.Equal(t, 3, l.Count("b"))
+	assert.Equal(t, 0, l.Count("c"))
+	assert.Equal(t, 0, l.Get("c").Len())
+
+	l.Get("a").Push(lookupObj{})
+	assert.Equal(t, 2, l.Count("a"))
+
+	g := l.Groups().Unwrap()
+	assert.Equal(t, 2, len(g))
+	assert.Equal(t, "b", g[0].Key)
+	assert.Equal(t, 3, g[0].Values.Len())
+	assert.Equal(t, "a", g[1].Key)
+	assert.Equal(t, "emil", g[1].Values.Unwrap()[1].name)
+
+	m := l.ToMap()
+	assert.Equal(t, 2, len(m))
+	assert.Equal(t, 3, m["b"].Len())
+}
+
+func TestMapGroups(t *testing.T) {
+	l := GroupBy[lookupObj](lookupObjs, func(v lookupObj, _ int) (string, string) {
+		return v.team, v.name
+	})
+	d := MapGroups(l, func(k string, vs *SliceOf[string], i int) string {
+		return strings.Join(vs.Unwrap(), ",")
+	})
+	assert.Equal(t, []Tuple[string, string]{
+		{"b", "anna,carl,dora"}, {"a", "bert,emil"},
+	}, d.Entries().Unwrap())
+}
+
+func TestThenGroupBy(t *testing.T) {
+	l := GroupBy[lookupObj](lookupObjs, byTeam)
+	d := ThenGroupBy(l, func(v lookupObj, _ int) (string, string) {
+		return v.role, v.name
+	})
+
+	assert.Equal(t, []string{"b", "a"}, d.Keys().Unwrap())
+	b, _ := d.Get("b")
+	assert.Equal(t, []string{"dev", "ops"}, b.Keys().Unwrap())
+	assert.Equal(t, []string{"anna", "dora"}, b.Get("dev").Unwrap())
+	a, _ := d.Get("a")
+	assert.Equal(t, []string{"ops"}, a.Keys().Unwrap())
+	assert.Equal(t, []string{"bert", "emil"}, a.Get("ops").Unwrap())
+}
@@ -0,0 +1,256 @@
+package sop
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is a constraint that permits
+// any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum returns the sum of all elements of
+// the Enumerable s. If s is empty, 0 is
+// returned.
+func Sum[T Number](s Enumerable[T]) (sum T) {
+	s.Each(func(v T, _ int) {
+		sum += v
+	})
+	return
+}
+
+// SumBy returns the sum of the values returned
+// by f for each element of the Enumerable s.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func SumBy[T any, N Number](s Enumerable[T], f func(v T, i int) N) (sum N) {
+	notNil("f", f)
+	s.Each(func(v T, i int) {
+		sum += f(v, i)
+	})
+	return
+}
+
+// Product returns the product of all elements
+// of the Enumerable s. If s is empty, 1 is
+// returned.
+func Product[T Number](s Enumerable[T]) (prod T) {
+	prod = 1
+	s.Each(func(v T, _ int) {
+		prod *= v
+	})
+	return
+}
+
+// Min returns the smallest element of the
+// Enumerable s and true. If s is empty,
+// default of T and false is returned.
+func Min[T constraints.Ordered](s Enumerable[T]) (T, bool) {
+	return MinBy(s, identity[T])
+}
+
+// Max returns the largest element of the
+// Enumerable s and true. If s is empty,
+// default of T and false is returned.
+func Max[T constraints.Ordered](s Enumerable[T]) (T, bool) {
+	return MaxBy(s, identity[T])
+}
+
+// MinBy returns the first element of the
+// Enumerable s with the smallest key returned
+// by f and true. If s is empty, default of T
+// and false is returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func MinBy[T any, K constraints.Ordered](s Enumerable[T], f func(v T, i int) K) (T, bool) {
+	return extremeBy(s, f, func(a, b K) bool {
+		return a < b
+	})
+}
+
+// MaxBy returns the first element of the
+// Enumerable s with the largest key returned
+// by f and true. If s is empty, default of T
+// and false is returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func MaxBy[T any, K constraints.Ordered](s Enumerable[T], f func(v T, i int) K) (T, bool) {
+	return extremeBy(s, f, func(a, b K) bool {
+		return a > b
+	})
+}
+
+// Average returns the arithmetic mean of all
+// elements of the Enumerable s. If s is empty,
+// NaN is returned.
+func Average[T Number](s Enumerable[T]) float64 {
+	return AverageBy(s, identity[T])
+}
+
+// AverageBy returns the arithmetic mean of the
+// values returned by f for each element of the
+// Enumerable s. If s is empty, NaN is returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func AverageBy[T any, N Number](s Enumerable[T], f func(v T, i int) N) float64 {
+	notNil("f", f)
+	if s.Len() == 0 {
+		return math.NaN()
+	}
+	var sum float64
+	s.Each(func(v T, i int) {
+		sum += float64(f(v, i))
+	})
+	return sum / float64(s.Len())
+}
+
+// Median returns the median of all elements
+// of the Enumerable s. If s has an even number
+// of elements, the mean of the two middle
+// elements is returned. If s is empty, NaN is
+// returned.
+func Median[T Number](s Enumerable[T]) float64 {
+	return MedianBy(s, identity[T])
+}
+
+// MedianBy returns the median of the values
+// returned by f for each element of the
+// Enumerable s. If s has an even number of
+// elements, the mean of the two middle values
+// is returned. If s is empty, NaN is returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func MedianBy[T any, N Number](s Enumerable[T], f func(v T, i int) N) float64 {
+	return PercentileBy(s, 50, f)
+}
+
+// Percentile returns the p-th percentile of all
+// elements of the Enumerable s, where p must be
+// in the range [0, 100]. Values between two
+// elements are linearly interpolated. If s is
+// empty, NaN is returned.
+func Percentile[T Number](s Enumerable[T], p float64) float64 {
+	return PercentileBy(s, p, identity[T])
+}
+
+// PercentileBy returns the p-th percentile of the
+// values returned by f for each element of the
+// Enumerable s, where p must be in the range
+// [0, 100]. Values between two elements are
+// linearly interpolated. If s is empty, NaN is
+// returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func PercentileBy[T any, N Number](s Enumerable[T], p float64, f func(v T, i int) N) float64 {
+	notNil("f", f)
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic(fmt.Sprintf("parameter p must be in range [0, 100] but was %v", p))
+	}
+	if s.Len() == 0 {
+		return math.NaN()
+	}
+	srt := make([]float64, 0, s.Len())
+	s.Each(func(v T, i int) {
+		srt = append(srt, float64(f(v, i)))
+	})
+	sort.Float64s(srt)
+	rank := p / 100 * float64(len(srt)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return srt[lo] + (srt[hi]-srt[lo])*(rank-float64(lo))
+}
+
+// Variance returns the population variance of
+// all elements of the Enumerable s. If s is
+// empty, NaN is returned.
+func Variance[T Number](s Enumerable[T]) float64 {
+	return VarianceBy(s, identity[T])
+}
+
+// VarianceBy returns the population variance of
+// the values returned by f for each element of
+// the Enumerable s. If s is empty, NaN is
+// returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func VarianceBy[T any, N Number](s Enumerable[T], f func(v T, i int) N) float64 {
+	notNil("f", f)
+	if s.Len() == 0 {
+		return math.NaN()
+	}
+	vals := make([]float64, 0, s.Len())
+	var sum float64
+	s.Each(func(v T, i int) {
+		fv := float64(f(v, i))
+		vals = append(vals, fv)
+		sum += fv
+	})
+	avg := sum / float64(len(vals))
+	var sq float64
+	for _, v := range vals {
+		d := v - avg
+		sq += d * d
+	}
+	return sq / float64(len(vals))
+}
+
+// StdDev returns the population standard
+// deviation of all elements of the Enumerable
+// s. If s is empty, NaN is returned.
+func StdDev[T Number](s Enumerable[T]) float64 {
+	return StdDevBy(s, identity[T])
+}
+
+// StdDevBy returns the population standard
+// deviation of the values returned by f for
+// each element of the Enumerable s. If s is
+// empty, NaN is returned.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func StdDevBy[T any, N Number](s Enumerable[T], f func(v T, i int) N) float64 {
+	return math.Sqrt(VarianceBy(s, f))
+}
+
+func identity[T any](v T, _ int) T {
+	return v
+}
+
+// extremeBy returns the first element of s where
+// the key returned by f is preferred over all
+// other keys by better.
+func extremeBy[T any, K constraints.Ordered](
+	s Enumerable[T],
+	f func(v T, i int) K,
+	better func(a, b K) bool,
+) (res T, ok bool) {
+	notNil("f", f)
+	var best K
+	s.Each(func(v T, i int) {
+		k := f(v, i)
+		if !ok || better(k, best) {
+			res, best, ok = v, k, true
+		}
+	})
+	return
+}
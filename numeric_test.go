@@ -0,0 +1,142 @@
+package sop
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 55, Sum[int](Range(1, 10)))
+	assert.Equal(t, 0, Sum[int](Slice([]int{})))
+	assert.InDelta(t, 1.5, Sum[float64](Slice([]float64{0.5, 1})), 1e-9)
+
+	r := SumBy[string](Slice([]string{"a", "bb", "ccc"}), func(v string, _ int) int {
+		return len(v)
+	})
+	assert.Equal(t, 6, r)
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 120, Product[int](Range(1, 5)))
+	assert.Equal(t, 1, Product[int](Slice([]int{})))
+}
+
+func TestMinMax(t *testing.T) {
+	w := Slice([]int{3, 1, 4, 1, 5})
+
+	v, ok := Min[int](w)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	v, ok = Max[int](w)
+	assert.True(t, ok)
+	assert.Equal(t, 5, v)
+
+	v, ok = Min[int](Slice([]int{}))
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	s, ok := Max[string](Slice([]string{"b", "c", "a"}))
+	assert.True(t, ok)
+	assert.Equal(t, "c", s)
+}
+
+func TestMinMaxBy(t *testing.T) {
+	w := Slice([]string{"bb", "a", "ccc", "d", "eee"})
+	l := func(v string, _ int) int {
+		return len(v)
+	}
+
+	v, ok := MinBy[string](w, l)
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+
+	v, ok = MaxBy[string](w, l)
+	assert.True(t, ok)
+	assert.Equal(t, "ccc", v)
+
+	_, ok = MaxBy[string](Slice([]string{}), l)
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		MinBy[string, int](w, nil)
+	})
+}
+
+func TestAverage(t *testing.T) {
+	assert.Equal(t, 2.5, Average[int](Slice([]int{1, 2, 3, 4})))
+	assert.True(t, math.IsNaN(Average[int](Slice([]int{}))))
+
+	r := AverageBy[string](Slice([]string{"a", "bb"}), func(v string, _ int) int {
+		return len(v)
+	})
+	assert.Equal(t, 1.5, r)
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, 3.0, Median[int](Slice([]int{5, 1, 3})))
+	assert.Equal(t, 2.5, Median[int](Slice([]int{4, 1, 3, 2})))
+	assert.Equal(t, 7.0, Median[int](Slice([]int{7})))
+	assert.True(t, math.IsNaN(Median[int](Slice([]int{}))))
+}
+
+func TestPercentile(t *testing.T) {
+	w := Range(1, 5)
+	assert.Equal(t, 1.0, Percentile[int](w, 0))
+	assert.Equal(t, 5.0, Percentile[int](w, 100))
+	assert.Equal(t, 2.0, Percentile[int](w, 25))
+	assert.Equal(t, 4.6, math.Round(Percentile[int](w, 90)*10)/10)
+
+	assert.Panics(t, func() {
+		Percentile[int](w, 101)
+	})
+	assert.Panics(t, func() {
+		Percentile[int](w, -1)
+	})
+}
+
+func TestVariance(t *testing.T) {
+	w := Slice([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Equal(t, 4.0, Variance[int](w))
+	assert.Equal(t, 2.0, StdDev[int](w))
+	assert.True(t, math.IsNaN(StdDev[int](Slice([]int{}))))
+}
+
+func TestStatsBy(t *testing.T) {
+	type obj struct {
+		name  string
+		score int
+	}
+	w := Map[int](Slice([]int{2, 4, 4, 4, 5, 5, 7, 9}), func(v, i int) obj {
+		return obj{string(rune('a' + i)), v}
+	})
+	score := func(v obj, _ int) int {
+		return v.score
+	}
+
+	assert.Equal(t, 4.5, MedianBy[obj](w, score))
+	assert.Equal(t, 2.0, PercentileBy[obj](w, 0, score))
+	assert.Equal(t, 9.0, PercentileBy[obj](w, 100, score))
+	assert.Equal(t, 4.0, VarianceBy[obj](w, score))
+	assert.Equal(t, 2.0, StdDevBy[obj](w, score))
+
+	var calls int
+	VarianceBy[obj](w, func(v obj, i int) int {
+		calls++
+		return v.score
+	})
+	assert.Equal(t, w.Len(), calls)
+
+	e := Slice([]obj{})
+	assert.True(t, math.IsNaN(MedianBy[obj](e, score)))
+	assert.True(t, math.IsNaN(StdDevBy[obj](e, score)))
+
+	assert.Panics(t, func() {
+		PercentileBy[obj](w, 101, score)
+	})
+	assert.Panics(t, func() {
+		VarianceBy[obj, int](w, nil)
+	})
+}
@@ -0,0 +1,184 @@
+package sop
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParallelEach performs the given function f on
+// each element in the Enumerable s. The elements
+// are split into contiguous shards which are
+// processed concurrently.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+//
+// You can pass the number of goroutines used
+// as workers. By default, GOMAXPROCS workers
+// are used. If f panics in any worker, the panic
+// is propagated to the caller after all workers
+// have finished.
+func ParallelEach[T any](s Enumerable[T], f func(v T, i int), workers ...int) {
+	notNil("f", f)
+	src := s.Unwrap()
+	runSharded(len(src), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(src[i], i)
+		}
+	})
+}
+
+// ParallelMap works like Map but performs the
+// function f concurrently on shards of the
+// Enumerable s. The order of the result
+// Enumerable is the same as of s.
+//
+// You can pass the number of goroutines used
+// as workers. By default, GOMAXPROCS workers
+// are used. If f panics in any worker, the panic
+// is propagated to the caller after all workers
+// have finished.
+func ParallelMap[TIn, TOut any](
+	s Enumerable[TIn],
+	f func(v TIn, i int) TOut,
+	workers ...int,
+) *SliceOf[TOut] {
+	notNil("f", f)
+	src := s.Unwrap()
+	res := make([]TOut, len(src))
+	runSharded(len(src), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			res[i] = f(src[i], i)
+		}
+	})
+	return Slice(res)
+}
+
+// ParallelFilter works like Filter but performs
+// preticate p concurrently on shards of the
+// Enumerable s. The order of the result
+// Enumerable is the same as of s.
+//
+// You can pass the number of goroutines used
+// as workers. By default, GOMAXPROCS workers
+// are used. If p panics in any worker, the panic
+// is propagated to the caller after all workers
+// have finished.
+func ParallelFilter[T any](
+	s Enumerable[T],
+	p func(v T, i int) bool,
+	workers ...int,
+) *SliceOf[T] {
+	notNil("p", p)
+	src := s.Unwrap()
+	keep := make([]bool, len(src))
+	runSharded(len(src), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			keep[i] = p(src[i], i)
+		}
+	})
+	res := newSliceFrom[T, T](s)
+	var j int
+	for i, ok := range keep {
+		if ok {
+			res[j] = src[i]
+			j++
+		}
+	}
+	return Slice(res[:j])
+}
+
+// ParallelAggregate works like Aggregate but
+// aggregates shards of the Enumerable s
+// concurrently and then aggregates the results
+// of the shards in order. Therefore, f must
+// be associative.
+//
+// You can pass the number of goroutines used
+// as workers. By default, GOMAXPROCS workers
+// are used. If f panics in any worker, the panic
+// is propagated to the caller after all workers
+// have finished.
+func ParallelAggregate[T any](s Enumerable[T], f func(a, b T) T, workers ...int) (c T) {
+	notNil("f", f)
+	src := s.Unwrap()
+	if len(src) == 0 {
+		return
+	}
+	n := workerCount(len(src), workers)
+	parts := make([]T, n)
+	runShards(len(src), n, func(shard, lo, hi int) {
+		parts[shard] = Slice(src[lo:hi]).Aggregate(f)
+	})
+	return Slice(parts).Aggregate(f)
+}
+
+// workerCount returns the number of workers
+// to be used for n elements, capped at n.
+func workerCount(n int, workers []int) (w int) {
+	if len(workers) != 0 && workers[0] > 0 {
+		w = workers[0]
+	} else {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if w > n {
+		w = n
+	}
+	return
+}
+
+// runSharded splits the range [0, n) into shards
+// and performs f on each shard concurrently.
+func runSharded(n int, workers []int, f func(lo, hi int)) {
+	runShards(n, workerCount(n, workers), func(_, lo, hi int) {
+		f(lo, hi)
+	})
+}
+
+// runShards splits the range [0, n) into w
+// contiguous shards and performs f on each
+// shard in its own goroutine. It blocks until
+// all shards are processed. The first recovered
+// panic of a shard is re-raised afterwards.
+func runShards(n, w int, f func(shard, lo, hi int)) {
+	if n == 0 {
+		return
+	}
+
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicked  bool
+		panicVal  interface{}
+	)
+
+	size := n / w
+	rest := n % w
+	lo := 0
+	for shard := 0; shard < w; shard++ {
+		hi := lo + size
+		if shard < rest {
+			hi++
+		}
+		wg.Add(1)
+		go func(shard, lo, hi int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() {
+						panicked = true
+						panicVal = r
+					})
+				}
+			}()
+			f(shard, lo, hi)
+		}(shard, lo, hi)
+		lo = hi
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(panicVal)
+	}
+}
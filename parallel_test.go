@@ -0,0 +1,88 @@
+package sop
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelEach(t *testing.T) {
+	w := Range(0, 1000)
+	res := make([]int, w.Len())
+	ParallelEach[int](w, func(v, i int) {
+		res[i] = v * 2
+	}, 7)
+	assert.Equal(t, Map[int](w, func(v, _ int) int {
+		return v * 2
+	}).Unwrap(), res)
+
+	var c int64
+	ParallelEach[int](Range(0, 3), func(_, _ int) {
+		atomic.AddInt64(&c, 1)
+	}, 10)
+	assert.Equal(t, int64(3), c)
+
+	assert.Panics(t, func() {
+		ParallelEach[int](Slice([]int{1}), nil)
+	})
+}
+
+func TestParallelMap(t *testing.T) {
+	w := Range(0, 1000)
+	r := ParallelMap[int](w, func(v, i int) [2]int {
+		return [2]int{v * v, i}
+	}, 4)
+	assert.Equal(t, Map[int](w, func(v, i int) [2]int {
+		return [2]int{v * v, i}
+	}).Unwrap(), r.Unwrap())
+
+	r = ParallelMap[int](Slice([]int{}), func(v, i int) [2]int {
+		return [2]int{}
+	})
+	assert.Equal(t, 0, r.Len())
+
+	assert.Panics(t, func() {
+		ParallelMap[int, int](Slice([]int{1}), nil)
+	})
+}
+
+func TestParallelFilter(t *testing.T) {
+	w := Range(0, 1000)
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+	r := ParallelFilter[int](w, even, 3)
+	assert.Equal(t, w.Filter(even).Unwrap(), r.Unwrap())
+
+	assert.Panics(t, func() {
+		ParallelFilter[int](Slice([]int{1}), nil)
+	})
+}
+
+func TestParallelAggregate(t *testing.T) {
+	sum := func(a, b int) int {
+		return a + b
+	}
+
+	w := Range(1, 1000)
+	assert.Equal(t, w.Aggregate(sum), ParallelAggregate[int](w, sum, 6))
+	assert.Equal(t, 1, ParallelAggregate[int](Slice([]int{1}), sum, 6))
+	assert.Equal(t, 0, ParallelAggregate[int](Slice([]int{}), sum))
+
+	concat := func(a, b string) string {
+		return a + b
+	}
+	s := Slice([]string{"a", "b", "c", "d", "e"})
+	assert.Equal(t, "abcde", ParallelAggregate[string](s, concat, 2))
+}
+
+func TestParallelPanic(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		ParallelEach[int](Range(0, 100), func(v, _ int) {
+			if v == 42 {
+				panic("boom")
+			}
+		}, 4)
+	})
+}
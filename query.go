@@ -0,0 +1,290 @@
+package sop
+
+// iterator returns the next value of a sequence
+// on each call. When the sequence is exhausted,
+// default of T and false is returned.
+type iterator[T any] func() (T, bool)
+
+// Query wraps a lazy pipeline of operations
+// on a source sequence.
+//
+// Stages like Filter, Take or Skip are only
+// recorded and are not performed until a
+// terminal operation like Unwrap, Count, First
+// or Aggregate is called. Elements are then
+// pulled one by one through the pipeline, so
+// no intermediate slices are allocated.
+//
+// Each terminal operation re-evaluates the
+// whole pipeline against the current state
+// of the source.
+type Query[T any] struct {
+	iter func() iterator[T]
+}
+
+// Lazy creates a new *Query[T] with the
+// given ReadOnly e, like any Enumerable,
+// as source.
+func Lazy[T any](e ReadOnly[T]) *Query[T] {
+	return &Query[T]{func() iterator[T] {
+		var i int
+		return func() (v T, ok bool) {
+			v, ok = e.At(i)
+			i++
+			return
+		}
+	}}
+}
+
+// LazyMap adds a stage to the Query q which
+// performs the passed function f on each
+// element and passes the return value on
+// to the next stage.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i in the stage.
+func LazyMap[TIn, TOut any](q *Query[TIn], f func(v TIn, i int) TOut) *Query[TOut] {
+	notNil("f", f)
+	return &Query[TOut]{func() iterator[TOut] {
+		next := q.iter()
+		var i int
+		return func() (r TOut, ok bool) {
+			v, ok := next()
+			if !ok {
+				return
+			}
+			r = f(v, i)
+			i++
+			return
+		}
+	}}
+}
+
+// Filter adds a stage to the Query which only
+// passes on elements where preticate p
+// returns true.
+//
+// p is getting passed the value v at the
+// current position as well as the current
+// index i in the stage.
+func (q *Query[T]) Filter(p func(v T, i int) bool) *Query[T] {
+	notNil("p", p)
+	return &Query[T]{func() iterator[T] {
+		next := q.iter()
+		var i int
+		return func() (v T, ok bool) {
+			for v, ok = next(); ok; v, ok = next() {
+				i++
+				if p(v, i-1) {
+					return
+				}
+			}
+			return
+		}
+	}}
+}
+
+// Take adds a stage to the Query which only
+// passes on the first n elements.
+func (q *Query[T]) Take(n int) *Query[T] {
+	return &Query[T]{func() iterator[T] {
+		next := q.iter()
+		var i int
+		return func() (v T, ok bool) {
+			if i >= n {
+				return
+			}
+			i++
+			return next()
+		}
+	}}
+}
+
+// Skip adds a stage to the Query which drops
+// the first n elements and passes on all
+// following elements.
+func (q *Query[T]) Skip(n int) *Query[T] {
+	return &Query[T]{func() iterator[T] {
+		next := q.iter()
+		var skipped bool
+		return func() (v T, ok bool) {
+			if !skipped {
+				skipped = true
+				for i := 0; i < n; i++ {
+					if _, ok = next(); !ok {
+						return
+					}
+				}
+			}
+			return next()
+		}
+	}}
+}
+
+// TakeWhile adds a stage to the Query which
+// passes on elements as long as preticate p
+// returns true.
+//
+// p is getting passed the value v at the
+// current position as well as the current
+// index i in the stage.
+func (q *Query[T]) TakeWhile(p func(v T, i int) bool) *Query[T] {
+	notNil("p", p)
+	return &Query[T]{func() iterator[T] {
+		next := q.iter()
+		var i int
+		var done bool
+		return func() (v T, ok bool) {
+			if done {
+				return
+			}
+			if v, ok = next(); ok && p(v, i) {
+				i++
+				return
+			}
+			done = true
+			return *new(T), false
+		}
+	}}
+}
+
+// SkipWhile adds a stage to the Query which
+// drops elements as long as preticate p
+// returns true and passes on all following
+// elements.
+//
+// p is getting passed the value v at the
+// current position as well as the current
+// index i in the stage.
+func (q *Query[T]) SkipWhile(p func(v T, i int) bool) *Query[T] {
+	notNil("p", p)
+	return &Query[T]{func() iterator[T] {
+		next := q.iter()
+		var skipped bool
+		return func() (v T, ok bool) {
+			if skipped {
+				return next()
+			}
+			skipped = true
+			for i := 0; ; i++ {
+				if v, ok = next(); !ok || !p(v, i) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// Each evaluates the Query and performs the
+// given function f on each resulting element.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func (q *Query[T]) Each(f func(v T, i int)) {
+	notNil("f", f)
+	next := q.iter()
+	var i int
+	for v, ok := next(); ok; v, ok = next() {
+		f(v, i)
+		i++
+	}
+}
+
+// Unwrap evaluates the Query and returns the
+// resulting elements as slice []T.
+func (q *Query[T]) Unwrap() (res []T) {
+	q.Each(func(v T, _ int) {
+		res = append(res, v)
+	})
+	return
+}
+
+// Collect evaluates the Query and packs the
+// resulting elements into an Enumerable[T].
+func (q *Query[T]) Collect() *SliceOf[T] {
+	return Slice(q.Unwrap())
+}
+
+// Len evaluates the Query and returns the
+// number of resulting elements.
+func (q *Query[T]) Len() (n int) {
+	next := q.iter()
+	for _, ok := next(); ok; _, ok = next() {
+		n++
+	}
+	return
+}
+
+// Any returns true when at least one resulting
+// element of the Query results in a true return
+// of p. The evaluation stops at the first match.
+func (q *Query[T]) Any(p func(v T, i int) bool) bool {
+	_, i := q.First(p)
+	return i != -1
+}
+
+// All returns true when all resulting elements
+// of the Query result in a true return of p.
+func (q *Query[T]) All(p func(v T, i int) bool) bool {
+	notNil("p", p)
+	return !q.Any(func(v T, i int) bool {
+		return !p(v, i)
+	})
+}
+
+// None returns true when no resulting element
+// of the Query results in a true return of p.
+func (q *Query[T]) None(p func(v T, i int) bool) bool {
+	return !q.Any(p)
+}
+
+// First returns the value and index of the first
+// resulting element of the Query where preticate
+// p returns true. The evaluation stops at the
+// first match.
+//
+// If this applies to no element, default of T
+// and -1 is returned.
+func (q *Query[T]) First(p func(v T, i int) bool) (T, int) {
+	notNil("p", p)
+	next := q.iter()
+	var i int
+	for v, ok := next(); ok; v, ok = next() {
+		if p(v, i) {
+			return v, i
+		}
+		i++
+	}
+	return *new(T), -1
+}
+
+// Count evaluates the Query and returns the
+// number of resulting elements which, when
+// applied on p, return true.
+func (q *Query[T]) Count(p func(v T, i int) bool) (c int) {
+	notNil("p", p)
+	q.Each(func(v T, i int) {
+		if p(v, i) {
+			c++
+		}
+	})
+	return
+}
+
+// Aggregate evaluates the Query and applies the
+// multiplicator function f over all resulting
+// elements and returns the final result.
+func (q *Query[T]) Aggregate(f func(a, b T) T) (c T) {
+	notNil("f", f)
+	next := q.iter()
+	c, ok := next()
+	if !ok {
+		return
+	}
+	for v, ok := next(); ok; v, ok = next() {
+		c = f(c, v)
+	}
+	return
+}
@@ -0,0 +1,126 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	w := Slice([]int{1, 2, 3})
+	q := Lazy[int](w)
+	assert.Equal(t, []int{1, 2, 3}, q.Unwrap())
+
+	w.Push(4)
+	assert.Equal(t, []int{1, 2, 3, 4}, q.Unwrap())
+
+	assert.Equal(t, []int(nil), Lazy[int](Slice([]int{})).Unwrap())
+}
+
+func TestQueryDeferred(t *testing.T) {
+	var calls int
+	q := Lazy[int](Slice([]int{1, 2, 3, 4, 5, 6})).
+		Filter(func(v, _ int) bool {
+			calls++
+			return v%2 == 0
+		})
+	assert.Equal(t, 0, calls)
+
+	v, i := q.First(func(v, _ int) bool {
+		return v > 2
+	})
+	assert.Equal(t, 4, v)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, 4, calls)
+}
+
+func TestLazyMap(t *testing.T) {
+	q := LazyMap(Lazy[int](Slice([]int{1, 2, 3})), func(v, i int) int {
+		return v*10 + i
+	})
+	assert.Equal(t, []int{10, 21, 32}, q.Unwrap())
+
+	assert.Panics(t, func() {
+		LazyMap[int, int](Lazy[int](Slice([]int{1})), nil)
+	})
+}
+
+func TestQueryFilter(t *testing.T) {
+	q := Lazy[int](Slice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})).
+		Filter(func(v, _ int) bool {
+			return v%2 == 0
+		}).
+		Filter(func(_, i int) bool {
+			return i%2 == 0
+		})
+	assert.Equal(t, []int{2, 6, 10}, q.Unwrap())
+
+	assert.Panics(t, func() {
+		Lazy[int](Slice([]int{1})).Filter(nil)
+	})
+}
+
+func TestQueryTakeSkip(t *testing.T) {
+	q := Lazy[int](Range(0, 10))
+	assert.Equal(t, []int{0, 1, 2}, q.Take(3).Unwrap())
+	assert.Equal(t, []int{7, 8, 9}, q.Skip(7).Unwrap())
+	assert.Equal(t, []int{3, 4}, q.Skip(3).Take(2).Unwrap())
+	assert.Equal(t, []int(nil), q.Skip(20).Unwrap())
+	assert.Equal(t, 10, q.Take(20).Len())
+}
+
+func TestQueryTakeSkipWhile(t *testing.T) {
+	q := Lazy[int](Slice([]int{1, 2, 3, 4, 1, 2}))
+	lt3 := func(v, _ int) bool {
+		return v < 3
+	}
+	assert.Equal(t, []int{1, 2}, q.TakeWhile(lt3).Unwrap())
+	assert.Equal(t, []int{3, 4, 1, 2}, q.SkipWhile(lt3).Unwrap())
+	assert.Equal(t, []int(nil), q.SkipWhile(func(_, _ int) bool {
+		return true
+	}).Unwrap())
+}
+
+func TestQueryCollect(t *testing.T) {
+	r := Lazy[int](Slice([]int{3, 1, 2})).
+		Filter(func(v, _ int) bool {
+			return v > 1
+		}).
+		Collect().
+		Sort(func(p, q, _ int) bool {
+			return p < q
+		})
+	assert.Equal(t, []int{2, 3}, r.Unwrap())
+}
+
+func TestQueryTerminals(t *testing.T) {
+	q := Lazy[int](Slice([]int{1, 2, 3, 4, 5}))
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	assert.Equal(t, 5, q.Len())
+	assert.Equal(t, 2, q.Count(even))
+	assert.True(t, q.Any(even))
+	assert.False(t, q.All(even))
+	assert.False(t, q.None(even))
+	assert.Equal(t, 15, q.Aggregate(func(a, b int) int {
+		return a + b
+	}))
+
+	v, i := q.First(func(v, _ int) bool {
+		return v > 10
+	})
+	assert.Equal(t, 0, v)
+	assert.Equal(t, -1, i)
+
+	assert.Equal(t, 0, Lazy[int](Slice([]int{})).Aggregate(func(a, b int) int {
+		return a + b
+	}))
+
+	m := map[int]int{}
+	q.Skip(3).Each(func(v, i int) {
+		m[i] = v
+	})
+	assert.Equal(t, map[int]int{0: 4, 1: 5}, m)
+}
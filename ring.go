@@ -0,0 +1,369 @@
+package sop
+
+import "math/rand"
+
+// ring is a growable circular buffer providing
+// O(1) operations at both ends. It implements
+// the Enumerable operations shared by Deque
+// and RingBuffer.
+//
+// If limit is greater than 0, the buffer never
+// grows beyond limit elements and pushing to a
+// full buffer overwrites the oldest element.
+type ring[T any] struct {
+	buf   []T
+	head  int
+	n     int
+	limit int
+}
+
+func (r *ring[T]) idx(i int) int {
+	return (r.head + i) % len(r.buf)
+}
+
+func (r *ring[T]) grow() {
+	c := 2 * len(r.buf)
+	if c < 4 {
+		c = 4
+	}
+	if r.limit > 0 && c > r.limit {
+		c = r.limit
+	}
+	buf := make([]T, c)
+	r.copyTo(buf)
+	r.buf = buf
+	r.head = 0
+}
+
+// copyTo copies the elements in order to
+// dst which must be large enough.
+func (r *ring[T]) copyTo(dst []T) {
+	if r.n == 0 {
+		return
+	}
+	end := r.head + r.n
+	if end <= len(r.buf) {
+		copy(dst, r.buf[r.head:end])
+		return
+	}
+	k := copy(dst, r.buf[r.head:])
+	copy(dst[k:], r.buf[:end-len(r.buf)])
+}
+
+func (r *ring[T]) view() *SliceOf[T] {
+	return Slice(r.Unwrap())
+}
+
+// PushBack appends the passed value v to
+// the end of the buffer.
+func (r *ring[T]) PushBack(v T) {
+	if r.limit > 0 && r.n == r.limit {
+		r.buf[r.head] = v
+		r.head = (r.head + 1) % len(r.buf)
+		return
+	}
+	if r.n == len(r.buf) {
+		r.grow()
+	}
+	r.buf[r.idx(r.n)] = v
+	r.n++
+}
+
+// PopBack removes the last element of the
+// buffer and returns its value. If the buffer
+// is empty, the default value of T is returned.
+func (r *ring[T]) PopBack() (v T) {
+	if r.n == 0 {
+		return
+	}
+	i := r.idx(r.n - 1)
+	v = r.buf[i]
+	r.buf[i] = *new(T)
+	r.n--
+	return
+}
+
+// PopFront removes the first element of the
+// buffer and returns its value. If the buffer
+// is empty, the default value of T is returned.
+func (r *ring[T]) PopFront() (v T) {
+	if r.n == 0 {
+		return
+	}
+	v = r.buf[r.head]
+	r.buf[r.head] = *new(T)
+	r.head = (r.head + 1) % len(r.buf)
+	r.n--
+	return
+}
+
+// PeekFront returns the first element of the
+// buffer and true. If the buffer is empty,
+// default of T and false is returned.
+func (r *ring[T]) PeekFront() (T, bool) {
+	return r.At(0)
+}
+
+// PeekBack returns the last element of the
+// buffer and true. If the buffer is empty,
+// default of T and false is returned.
+func (r *ring[T]) PeekBack() (T, bool) {
+	return r.At(r.n - 1)
+}
+
+// Unwrap returns a copy of the elements
+// of the buffer in order as slice []T.
+func (r *ring[T]) Unwrap() []T {
+	s := make([]T, r.n)
+	r.copyTo(s)
+	return s
+}
+
+// Len returns the number of elements
+// in the buffer.
+func (r *ring[T]) Len() int {
+	return r.n
+}
+
+// Each performs the given function f on each
+// element in the buffer.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func (r *ring[T]) Each(f func(v T, i int)) {
+	notNil("f", f)
+	for i := 0; i < r.n; i++ {
+		f(r.buf[r.idx(i)], i)
+	}
+}
+
+// Filter performs preticate p on each element
+// in the buffer and each element where p
+// returns true will be added to the result
+// Enumerable.
+func (r *ring[T]) Filter(p func(v T, i int) bool) Enumerable[T] {
+	return r.view().Filter(p)
+}
+
+// Any returns true when at least one element in
+// the buffer result in a true return of p when
+// performed on p.
+func (r *ring[T]) Any(p func(v T, i int) bool) bool {
+	notNil("p", p)
+	for i := 0; i < r.n; i++ {
+		if p(r.buf[r.idx(i)], i) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true when all elements in the
+// buffer result in a true return of p when
+// performed on p.
+func (r *ring[T]) All(p func(v T, i int) bool) bool {
+	notNil("p", p)
+	return !r.Any(func(v T, i int) bool {
+		return !p(v, i)
+	})
+}
+
+// None returns true when no element in the
+// buffer results in a true return of p when
+// performed on p.
+func (r *ring[T]) None(p func(v T, i int) bool) bool {
+	return !r.Any(p)
+}
+
+// First returns the value and index of the first
+// occurence in the buffer where preticate p
+// returns true.
+//
+// If this applies to no element in the buffer,
+// default of T and -1 is returned.
+func (r *ring[T]) First(p func(v T, i int) bool) (rv T, ri int) {
+	notNil("p", p)
+	ri = -1
+	r.Any(func(v T, i int) bool {
+		ok := p(v, i)
+		if ok {
+			rv, ri = v, i
+		}
+		return ok
+	})
+	return
+}
+
+// Count returns the number of elements in the
+// buffer which, when applied on p, return true.
+func (r *ring[T]) Count(p func(v T, i int) bool) (c int) {
+	notNil("p", p)
+	r.Each(func(v T, i int) {
+		if p(v, i) {
+			c++
+		}
+	})
+	return
+}
+
+// Shuffle returns the elements of the buffer
+// in a pseudo-random order as new Enumerable.
+//
+// You can also pass a custom random source rngSrc if
+// you desire.
+func (r *ring[T]) Shuffle(rngSrc ...rand.Source) Enumerable[T] {
+	return r.view().Shuffle(rngSrc...)
+}
+
+// Sort returns the elements of the buffer ordered
+// by the provided less function as new Enumerable.
+func (r *ring[T]) Sort(less func(p, q T, i int) bool) Enumerable[T] {
+	return r.view().Sort(less)
+}
+
+// SortStable returns the elements of the buffer
+// ordered by the provided less function as new
+// Enumerable while keeping the original order of
+// equal elements.
+func (r *ring[T]) SortStable(less func(p, q T, i int) bool) Enumerable[T] {
+	return r.view().SortStable(less)
+}
+
+// Aggregate applies the multiplicator function f over
+// all elements of the buffer and returns the final
+// result.
+func (r *ring[T]) Aggregate(f func(a, b T) T) T {
+	return r.view().Aggregate(f)
+}
+
+// Push appends the passed value v to the end
+// of the buffer.
+func (r *ring[T]) Push(v T) {
+	r.PushBack(v)
+}
+
+// Pop removes the last element of the buffer and
+// returns its value. If the buffer is empty, the
+// default value of T is returned.
+func (r *ring[T]) Pop() T {
+	return r.PopBack()
+}
+
+// Append adds all elements of Enumerable v to the
+// end of the buffer. The elements of v are copied
+// first, so the buffer can be appended to itself.
+func (r *ring[T]) Append(v Enumerable[T]) {
+	for _, e := range v.Unwrap() {
+		r.PushBack(e)
+	}
+}
+
+// Flush removes all elements of the buffer.
+func (r *ring[T]) Flush() {
+	for i := range r.buf {
+		r.buf[i] = *new(T)
+	}
+	r.head = 0
+	r.n = 0
+}
+
+// Splice removes the values from the buffer
+// starting at i with the amount of n. The removed
+// values are returned as new Slice.
+func (r *ring[T]) Splice(i, n int) Enumerable[T] {
+	s := r.Unwrap()
+	res := Slice(copySlice(s[i : i+n]))
+	s = append(s[:i], s[i+n:]...)
+	r.Flush()
+	copy(r.buf, s)
+	r.n = len(s)
+	return res
+}
+
+// At safely accesses the element in the buffer
+// at the given index i and returns it, if existent.
+// If there is no value at i, default of T and false
+// is returned.
+func (r *ring[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= r.n {
+		return
+	}
+	return r.buf[r.idx(i)], true
+}
+
+// Replace safely replaces the value in the buffer
+// at the given index i with the given value v and
+// returns true if the value was replaced. If the
+// buffer has no value at i, false is returned.
+func (r *ring[T]) Replace(i int, v T) (ok bool) {
+	if i < 0 || i >= r.n {
+		return
+	}
+	r.buf[r.idx(i)] = v
+	return true
+}
+
+// Deque is a double-ended queue with O(1)
+// operations at both ends.
+//
+// The zero value of Deque is an empty Deque
+// ready to use.
+type Deque[T any] struct {
+	ring[T]
+}
+
+var _ Enumerable[any] = (*Deque[any])(nil)
+
+// NewDeque creates a new *Deque[T]
+// containing the given values.
+func NewDeque[T any](vals ...T) (d *Deque[T]) {
+	d = &Deque[T]{}
+	for _, v := range vals {
+		d.PushBack(v)
+	}
+	return
+}
+
+// PushFront inserts the passed value v at
+// the start of the Deque.
+func (d *Deque[T]) PushFront(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+}
+
+// RingBuffer is a buffer with a fixed capacity.
+// When pushing to a full RingBuffer, the oldest
+// element is overwritten.
+//
+// A RingBuffer must be created using NewRingBuffer.
+type RingBuffer[T any] struct {
+	ring[T]
+}
+
+var _ Enumerable[any] = (*RingBuffer[any])(nil)
+
+// NewRingBuffer creates a new empty
+// *RingBuffer[T] with the given capacity.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	positive("capacity", capacity)
+	return &RingBuffer[T]{ring[T]{
+		buf:   make([]T, capacity),
+		limit: capacity,
+	}}
+}
+
+// Cap returns the capacity of the RingBuffer.
+func (r *RingBuffer[T]) Cap() int {
+	return r.limit
+}
+
+// IsFull returns true if the RingBuffer
+// contains as many elements as its capacity.
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.n == r.limit
+}
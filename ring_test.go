@@ -0,0 +1,161 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, 0, d.PopFront())
+	assert.Equal(t, 0, d.PopBack())
+
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.PushFront(0)
+	assert.Equal(t, []int{0, 1, 2, 3}, d.Unwrap())
+
+	v, ok := d.PeekFront()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	v, ok = d.PeekBack()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	assert.Equal(t, 0, d.PopFront())
+	assert.Equal(t, 3, d.PopBack())
+	assert.Equal(t, []int{1, 2}, d.Unwrap())
+
+	_, ok = NewDeque[int]().PeekBack()
+	assert.False(t, ok)
+}
+
+func TestDequeGrow(t *testing.T) {
+	d := NewDeque[int]()
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+		if i%7 == 0 {
+			d.PopFront()
+		}
+	}
+
+	exp := []int{}
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			exp = append(exp, i)
+		} else {
+			exp = append([]int{i}, exp...)
+		}
+		if i%7 == 0 {
+			exp = exp[1:]
+		}
+	}
+	assert.Equal(t, exp, d.Unwrap())
+}
+
+func TestDequeEnumerable(t *testing.T) {
+	d := NewDeque(3, 4, 5)
+	d.PushFront(2)
+	d.PushFront(1)
+	var e Enumerable[int] = d
+	even := func(v, _ int) bool {
+		return v%2 == 0
+	}
+
+	assert.Equal(t, []int{2, 4}, e.Filter(even).Unwrap())
+	assert.True(t, e.Any(even))
+	assert.False(t, e.All(even))
+	assert.False(t, e.None(even))
+	assert.Equal(t, 2, e.Count(even))
+	assert.Equal(t, 15, e.Aggregate(func(a, b int) int {
+		return a + b
+	}))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, e.Sort(func(p, q, _ int) bool {
+		return p > q
+	}).Unwrap())
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, e.Shuffle().Unwrap())
+
+	v, i := e.First(func(v, _ int) bool {
+		return v > 3
+	})
+	assert.Equal(t, 4, v)
+	assert.Equal(t, 3, i)
+
+	m := map[int]int{}
+	e.Each(func(v, i int) {
+		m[i] = v
+	})
+	assert.Equal(t, map[int]int{0: 1, 1: 2, 2: 3, 3: 4, 4: 5}, m)
+
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, Map[int](e, func(v, _ int) string {
+		return string(rune('0' + v))
+	}).Unwrap())
+
+	assert.True(t, e.Replace(0, 0))
+	assert.False(t, e.Replace(5, 0))
+	assert.Equal(t, []int{2, 3}, e.Splice(1, 2).Unwrap())
+	assert.Equal(t, []int{0, 4, 5}, e.Unwrap())
+
+	e.Append(Slice([]int{6}))
+	e.Push(7)
+	assert.Equal(t, 7, e.Pop())
+	assert.Equal(t, []int{0, 4, 5, 6}, e.Unwrap())
+
+	e.Flush()
+	assert.Equal(t, 0, e.Len())
+	d.PushFront(1)
+	assert.Equal(t, []int{1}, e.Unwrap())
+}
+
+func TestRingBuffer(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	assert.Equal(t, 3, r.Cap())
+	assert.False(t, r.IsFull())
+
+	r.Push(1)
+	r.Push(2)
+	r.Push(3)
+	assert.True(t, r.IsFull())
+	assert.Equal(t, []int{1, 2, 3}, r.Unwrap())
+
+	r.Push(4)
+	r.Push(5)
+	assert.Equal(t, []int{3, 4, 5}, r.Unwrap())
+	assert.Equal(t, 3, r.Len())
+
+	assert.Equal(t, 3, r.PopFront())
+	assert.Equal(t, 5, r.Pop())
+	assert.Equal(t, []int{4}, r.Unwrap())
+
+	r.Append(Range(10, 5))
+	assert.Equal(t, []int{12, 13, 14}, r.Unwrap())
+
+	assert.Equal(t, []int{13}, r.Splice(1, 1).Unwrap())
+	r.Push(15)
+	r.Push(16)
+	assert.Equal(t, []int{14, 15, 16}, r.Unwrap())
+
+	assert.Equal(t, 45, Sum[int](r))
+
+	assert.Panics(t, func() {
+		NewRingBuffer[int](0)
+	})
+}
+
+func TestRingAppendSelf(t *testing.T) {
+	d := NewDeque(1, 2)
+	d.Append(d)
+	assert.Equal(t, []int{1, 2, 1, 2}, d.Unwrap())
+
+	r := NewRingBuffer[int](3)
+	r.Append(Slice([]int{1, 2, 3}))
+	r.Append(r)
+	assert.Equal(t, []int{1, 2, 3}, r.Unwrap())
+}
@@ -1,21 +1,36 @@
 package sop
 
-// set wraos a slice and ensures that
+import "container/heap"
+
+// SetOf wraps a slice and ensures that
 // each element in the set is unique
 // inside the set.
-type set[T comparable] struct {
-	*slice[T]
+//
+// The zero value of SetOf is an empty
+// set ready to use.
+//
+// Next to the ordered slice, the set keeps
+// an index of the position of each element,
+// so that Contains, Push and Replace are
+// performed in constant time.
+//
+// The slice returned by Unwrap must not be
+// modified directly, because this would
+// invalidate the index of the set.
+type SetOf[T comparable] struct {
+	slice[T]
+	idx map[T]int
 }
 
-var _ Enumerable[int] = (*set[int])(nil)
+var _ SetEnumerable[int] = (*SetOf[int])(nil)
 
 // Set packs a given slice []T into a
-// *set[T] object. A set acts as same as
+// *SetOf[T] object. A set acts as same as
 // a slice but guarantees that each element
 // in the set is unique inside the set.
-func Set[T comparable](s []T) (st *set[T]) {
-	st = &set[T]{
-		slice: &slice[T]{},
+func Set[T comparable](s []T) (st *SetOf[T]) {
+	st = &SetOf[T]{
+		idx: make(map[T]int, len(s)),
 	}
 	st.Append(Slice(s))
 	return
@@ -23,27 +38,210 @@ func Set[T comparable](s []T) (st *set[T]) {
 
 // Contains returns true if the given
 // element v is contained in the set.
-func (s *set[T]) Contains(v T) bool {
-	return s.Any(func(c T, _ int) bool {
-		return c == v
-	})
+func (s *SetOf[T]) Contains(v T) (ok bool) {
+	_, ok = s.idx[v]
+	return
 }
 
-func (s *set[T]) Push(v T) {
+// Push appends the passed value v to the
+// set if it is not already contained.
+func (s *SetOf[T]) Push(v T) {
 	if !s.Contains(v) {
+		if s.idx == nil {
+			s.idx = make(map[T]int)
+		}
+		s.idx[v] = s.Len()
 		s.slice.Push(v)
 	}
 }
 
-func (s *set[T]) Append(v Enumerable[T]) {
+// Pop removes the last element of the set and
+// returns its value. If the set is empty, the
+// default value of T is returned.
+func (s *SetOf[T]) Pop() (res T) {
+	if s.Len() == 0 {
+		return
+	}
+	res = s.slice.Pop()
+	delete(s.idx, res)
+	return
+}
+
+// Append adds all elements of Enumerable v to
+// the end of the set which are not already
+// contained.
+func (s *SetOf[T]) Append(v Enumerable[T]) {
 	v.Each(func(e T, _ int) {
 		s.Push(e)
 	})
 }
 
-func (s *set[T]) Replace(i int, v T) (ok bool) {
+// Flush removes all elements of the given set.
+func (s *SetOf[T]) Flush() {
+	s.slice.Flush()
+	s.idx = make(map[T]int)
+}
+
+// Splice removes the values from the given set
+// starting at i with the amount of n. The removed
+// values are returned as new Slice.
+func (s *SetOf[T]) Splice(i, n int) (res Enumerable[T]) {
+	res = s.slice.Splice(i, n)
+	res.Each(func(v T, _ int) {
+		delete(s.idx, v)
+	})
+	for j := i; j < s.Len(); j++ {
+		s.idx[s.s[j]] = j
+	}
+	return
+}
+
+// Replace safely replaces the value in the set
+// at the given index i with the given value v and
+// returns true if the value was replaced. If the
+// set has no value at i or v is already contained
+// in the set, false is returned.
+func (s *SetOf[T]) Replace(i int, v T) (ok bool) {
 	if s.Contains(v) {
 		return
 	}
+	old, exists := s.At(i)
+	if !exists {
+		return
+	}
+	delete(s.idx, old)
+	s.idx[v] = i
 	return s.slice.Replace(i, v)
 }
+
+// Union returns a new set containing all elements
+// of the set followed by all elements of v which
+// are not contained in the set.
+func (s *SetOf[T]) Union(v Enumerable[T]) (res *SetOf[T]) {
+	res = Set(s.Unwrap())
+	res.Append(v)
+	return
+}
+
+// Intersect returns a new set containing all
+// elements of the set which are also contained
+// in v.
+func (s *SetOf[T]) Intersect(v Enumerable[T]) *SetOf[T] {
+	return s.filterSet(lookupOf(v))
+}
+
+// Difference returns a new set containing all
+// elements of the set which are not contained
+// in v.
+func (s *SetOf[T]) Difference(v Enumerable[T]) *SetOf[T] {
+	contains := lookupOf(v)
+	return s.filterSet(func(e T) bool {
+		return !contains(e)
+	})
+}
+
+// SymmetricDifference returns a new set containing
+// all elements of the set which are not contained
+// in v followed by all elements of v which are not
+// contained in the set.
+func (s *SetOf[T]) SymmetricDifference(v Enumerable[T]) (res *SetOf[T]) {
+	res = s.Difference(v)
+	v.Each(func(e T, _ int) {
+		if !s.Contains(e) {
+			res.Push(e)
+		}
+	})
+	return
+}
+
+// IsSubsetOf returns true if all elements of
+// the set are contained in v.
+func (s *SetOf[T]) IsSubsetOf(v Enumerable[T]) bool {
+	contains := lookupOf(v)
+	return s.All(func(e T, _ int) bool {
+		return contains(e)
+	})
+}
+
+// IsSupersetOf returns true if all elements
+// of v are contained in the set.
+func (s *SetOf[T]) IsSupersetOf(v Enumerable[T]) bool {
+	return v.All(func(e T, _ int) bool {
+		return s.Contains(e)
+	})
+}
+
+// IsDisjoint returns true if no element of v
+// is contained in the set.
+func (s *SetOf[T]) IsDisjoint(v Enumerable[T]) bool {
+	return v.None(func(e T, _ int) bool {
+		return s.Contains(e)
+	})
+}
+
+// Equal returns true if the set and v contain
+// the same elements, regardless of their order.
+func (s *SetOf[T]) Equal(v Enumerable[T]) bool {
+	return s.IsSupersetOf(v) && s.IsSubsetOf(v)
+}
+
+func (s *SetOf[T]) filterSet(p func(v T) bool) (res *SetOf[T]) {
+	res = Set[T](nil)
+	s.Each(func(v T, _ int) {
+		if p(v) {
+			res.Push(v)
+		}
+	})
+	return
+}
+
+// lookupOf returns a function checking if
+// an element is contained in e. If e is not
+// a set, an index of its elements is built.
+func lookupOf[T comparable](e Enumerable[T]) func(v T) bool {
+	if st, ok := e.(*SetOf[T]); ok {
+		return st.Contains
+	}
+	idx := make(map[T]struct{}, e.Len())
+	e.Each(func(v T, _ int) {
+		idx[v] = struct{}{}
+	})
+	return func(v T) (ok bool) {
+		_, ok = idx[v]
+		return
+	}
+}
+
+// SetInterface returns an adapter of the set s
+// which implements sort.Interface and heap.Interface
+// using the given less function, so that the set
+// can be used with the sort and container/heap
+// packages. All operations on the adapter are
+// performed on the set and keep its index intact.
+// Pushing a value which is already contained in
+// the set has no effect.
+func SetInterface[T comparable](s *SetOf[T], less func(p, q T, i int) bool) heap.Interface {
+	notNil("less", less)
+	return &setInterface[T]{sliceInterface[T]{&s.slice, less}, s}
+}
+
+// setInterface implements sort.Interface and
+// heap.Interface on top of a set.
+type setInterface[T comparable] struct {
+	sliceInterface[T]
+	set *SetOf[T]
+}
+
+func (a *setInterface[T]) Swap(i, j int) {
+	a.sliceInterface.Swap(i, j)
+	a.set.idx[a.set.s[i]] = i
+	a.set.idx[a.set.s[j]] = j
+}
+
+func (a *setInterface[T]) Push(x interface{}) {
+	a.set.Push(x.(T))
+}
+
+func (a *setInterface[T]) Pop() interface{} {
+	return a.set.Pop()
+}
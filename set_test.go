@@ -1,6 +1,7 @@
 package sop
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,3 +58,151 @@ func TestSetReplace(t *testing.T) {
 	assert.Equal(t, []int{1, 4, 5}, w.Unwrap())
 	assert.True(t, ok)
 }
+
+func TestSetContains(t *testing.T) {
+	s := Set([]int{1, 2, 3})
+	assert.True(t, s.Contains(2))
+	assert.False(t, s.Contains(4))
+
+	s = Set(([]int)(nil))
+	assert.False(t, s.Contains(0))
+}
+
+func TestSetPop(t *testing.T) {
+	s := Set([]int{1, 2, 3})
+	assert.Equal(t, 3, s.Pop())
+	assert.Equal(t, []int{1, 2}, s.Unwrap())
+	assert.False(t, s.Contains(3))
+
+	s.Push(3)
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	s = Set([]int{})
+	assert.Equal(t, 0, s.Pop())
+}
+
+func TestSetFlush(t *testing.T) {
+	s := Set([]int{1, 2, 3})
+	s.Flush()
+	assert.Equal(t, []int{}, s.Unwrap())
+	assert.False(t, s.Contains(1))
+
+	s.Push(1)
+	assert.Equal(t, []int{1}, s.Unwrap())
+}
+
+func TestSetSplice(t *testing.T) {
+	s := Set([]int{1, 2, 3, 4, 5})
+	r := s.Splice(1, 2)
+	assert.Equal(t, []int{2, 3}, r.Unwrap())
+	assert.Equal(t, []int{1, 4, 5}, s.Unwrap())
+	assert.False(t, s.Contains(2))
+	assert.False(t, s.Contains(3))
+
+	s.Push(2)
+	assert.Equal(t, []int{1, 4, 5, 2}, s.Unwrap())
+
+	ok := s.Replace(1, 3)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 3, 5, 2}, s.Unwrap())
+	assert.True(t, s.Contains(3))
+	assert.False(t, s.Contains(4))
+
+	s.Push(4)
+	assert.Equal(t, []int{1, 3, 5, 2, 4}, s.Unwrap())
+}
+
+func TestSetLarge(t *testing.T) {
+	s := Set(Range(0, 100_000).Unwrap())
+	s.Append(Range(50_000, 100_000))
+	assert.Equal(t, 150_000, s.Len())
+	assert.True(t, s.Contains(149_999))
+	assert.False(t, s.Contains(150_000))
+}
+
+func TestSetUnion(t *testing.T) {
+	s := Set([]int{3, 1, 2})
+	r := s.Union(Slice([]int{4, 2, 5, 4}))
+	assert.Equal(t, []int{3, 1, 2, 4, 5}, r.Unwrap())
+	assert.Equal(t, []int{3, 1, 2}, s.Unwrap())
+}
+
+func TestSetIntersect(t *testing.T) {
+	s := Set([]int{3, 1, 2, 4})
+	r := s.Intersect(Set([]int{4, 2, 5}))
+	assert.Equal(t, []int{2, 4}, r.Unwrap())
+
+	r = s.Intersect(Slice([]int{}))
+	assert.Equal(t, []int(nil), r.Unwrap())
+}
+
+func TestSetDifference(t *testing.T) {
+	s := Set([]int{3, 1, 2, 4})
+	r := s.Difference(Slice([]int{4, 2, 5}))
+	assert.Equal(t, []int{3, 1}, r.Unwrap())
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	s := Set([]int{3, 1, 2, 4})
+	r := s.SymmetricDifference(Slice([]int{6, 4, 2, 5, 6}))
+	assert.Equal(t, []int{3, 1, 6, 5}, r.Unwrap())
+}
+
+func TestSetRelations(t *testing.T) {
+	s := Set([]int{1, 2, 3})
+
+	assert.True(t, s.IsSubsetOf(Slice([]int{3, 2, 1, 4})))
+	assert.False(t, s.IsSubsetOf(Slice([]int{1, 2})))
+
+	assert.True(t, s.IsSupersetOf(Slice([]int{1, 3, 3})))
+	assert.False(t, s.IsSupersetOf(Set([]int{1, 4})))
+
+	assert.True(t, s.IsDisjoint(Slice([]int{4, 5})))
+	assert.False(t, s.IsDisjoint(Slice([]int{5, 3})))
+
+	assert.True(t, s.Equal(Slice([]int{3, 1, 2, 1})))
+	assert.False(t, s.Equal(Slice([]int{1, 2})))
+	assert.False(t, s.Equal(Set([]int{1, 2, 3, 4})))
+}
+
+func TestSetZero(t *testing.T) {
+	var s SetOf[int]
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Contains(1))
+	assert.Equal(t, 0, s.Pop())
+	assert.False(t, s.Replace(0, 1))
+
+	s.Push(1)
+	s.Append(Slice([]int{2, 1, 3}))
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+	assert.True(t, s.Contains(3))
+}
+
+func TestSetEnumerable(t *testing.T) {
+	var e Enumerable[int] = Set([]int{1, 2})
+	s, ok := e.(SetEnumerable[int])
+	assert.True(t, ok)
+	assert.True(t, s.Contains(2))
+	assert.Equal(t, []int{1, 2, 3}, s.Union(Slice([]int{3})).Unwrap())
+
+	_, ok = Enumerable[int](Slice([]int{1})).(SetEnumerable[int])
+	assert.False(t, ok)
+}
+
+// assertUnexposed asserts that v has no exported
+// fields which would allow bypassing its methods.
+func assertUnexposed(t *testing.T, v interface{}) {
+	rt := reflect.TypeOf(v)
+	for i := 0; i < rt.NumField(); i++ {
+		assert.False(t, rt.Field(i).IsExported(), "%s.%s", rt.Name(), rt.Field(i).Name)
+	}
+}
+
+func TestSetUnexposed(t *testing.T) {
+	assertUnexposed(t, SetOf[int]{})
+
+	var s SetOf[int]
+	s.Push(1)
+	s.Push(1)
+	assert.Equal(t, []int{1}, s.Unwrap())
+}
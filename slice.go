@@ -1,23 +1,36 @@
 package sop
 
 import (
+	"container/heap"
 	"math/rand"
 	"sort"
 	"time"
 )
 
-// slice wraps a native slice to perform
+// SliceOf wraps a native slice to perform
 // operations on it.
+//
+// The zero value of SliceOf is an empty
+// slice ready to use.
+type SliceOf[T any] struct {
+	slice[T]
+}
+
+// slice implements the operations of SliceOf.
+// It is embedded into collections built on top
+// of a slice, like SetOf, without exposing the
+// slice itself, so that their invariants can
+// not be bypassed.
 type slice[T any] struct {
 	s []T
 }
 
-var _ Enumerable[any] = (*slice[any])(nil)
+var _ Enumerable[any] = (*SliceOf[any])(nil)
 
 // Slice packs a given slice []T into a
-// *slice[T] object.
-func Slice[T any](s []T) *slice[T] {
-	return &slice[T]{s}
+// *SliceOf[T] object.
+func Slice[T any](s []T) *SliceOf[T] {
+	return &SliceOf[T]{slice[T]{s}}
 }
 
 // Unwrap returns the originaly packed
@@ -161,6 +174,28 @@ func (s *slice[T]) Sort(less func(p, q T, i int) bool) Enumerable[T] {
 	return Slice(res)
 }
 
+// SortStable re-orders the slice x given the provided
+// less function while keeping the original order of
+// equal elements.
+//
+// less is getting passed the index i of p in
+// the original slice.
+func (s *slice[T]) SortStable(less func(p, q T, i int) bool) Enumerable[T] {
+	notNil("less", less)
+	idx := make([]int, len(s.s))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return less(s.s[idx[i]], s.s[idx[j]], idx[i])
+	})
+	res := newSliceFrom[T, T](s)
+	for i, j := range idx {
+		res[i] = s.s[j]
+	}
+	return Slice(res)
+}
+
 // Aggregate applies tze multiplicator function f over
 // all elements of the given Slice and returns the final
 // result.
@@ -239,3 +274,45 @@ func (s *slice[T]) Replace(i int, v T) (ok bool) {
 	ok = true
 	return
 }
+
+// SliceInterface returns an adapter of the Slice s
+// which implements sort.Interface and heap.Interface
+// using the given less function, so that the Slice
+// can be used with the sort and container/heap
+// packages. All operations on the adapter are
+// performed on the Slice.
+//
+// Because the Push and Pop methods of the Slice
+// are typed, it can not implement heap.Interface
+// directly. Use SetInterface for sets.
+func SliceInterface[T any](s *SliceOf[T], less func(p, q T, i int) bool) heap.Interface {
+	notNil("less", less)
+	return &sliceInterface[T]{&s.slice, less}
+}
+
+// sliceInterface implements sort.Interface and
+// heap.Interface on top of a Slice.
+type sliceInterface[T any] struct {
+	s    *slice[T]
+	less func(p, q T, i int) bool
+}
+
+func (a *sliceInterface[T]) Len() int {
+	return a.s.Len()
+}
+
+func (a *sliceInterface[T]) Less(i, j int) bool {
+	return a.less(a.s.s[i], a.s.s[j], i)
+}
+
+func (a *sliceInterface[T]) Swap(i, j int) {
+	a.s.s[i], a.s.s[j] = a.s.s[j], a.s.s[i]
+}
+
+func (a *sliceInterface[T]) Push(x interface{}) {
+	a.s.Push(x.(T))
+}
+
+func (a *sliceInterface[T]) Pop() interface{} {
+	return a.s.Pop()
+}
@@ -277,3 +277,36 @@ func TestReplace(t *testing.T) {
 	assert.Equal(t, []int{1, 4, 5}, w.Unwrap())
 	assert.True(t, ok)
 }
+
+func TestSortStable(t *testing.T) {
+	type kv struct {
+		K int
+		V string
+	}
+	w := Slice([]kv{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}})
+	res := w.SortStable(func(p, q kv, _ int) bool {
+		return p.K < q.K
+	})
+	assert.Equal(t, []kv{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}, {2, "e"}}, res.Unwrap())
+	assert.Equal(t, kv{2, "a"}, w.Unwrap()[0])
+
+	res = w.SortStable(func(p, q kv, i int) bool {
+		assert.Equal(t, w.Unwrap()[i], p)
+		return p.K < q.K
+	})
+	assert.Equal(t, []kv{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}, {2, "e"}}, res.Unwrap())
+
+	assert.Panics(t, func() {
+		Slice([]int{1}).SortStable(nil)
+	})
+}
+
+func TestSliceZero(t *testing.T) {
+	var w SliceOf[int]
+	assert.Equal(t, 0, w.Len())
+	assert.Equal(t, 0, w.Pop())
+
+	w.Push(1)
+	w.Append(Slice([]int{2, 3}))
+	assert.Equal(t, []int{1, 2, 3}, w.Unwrap())
+}
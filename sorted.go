@@ -0,0 +1,253 @@
+package sop
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SortedSlice is a Slice which keeps its elements
+// ordered by a less function. Pushed values are
+// inserted at their position in O(log n) + O(n)
+// and lookups are performed via binary search.
+//
+// A SortedSlice must be created using
+// NewSortedSlice or SortedSliceFrom.
+type SortedSlice[T any] struct {
+	sorted[T]
+}
+
+// sorted implements the operations of SortedSlice
+// which are shared with SortedSet.
+type sorted[T any] struct {
+	slice[T]
+	less func(p, q T, i int) bool
+}
+
+var _ Enumerable[any] = (*SortedSlice[any])(nil)
+
+// NewSortedSlice creates a new empty *SortedSlice[T]
+// ordered by the given less function, like util.Asc
+// or util.Desc.
+func NewSortedSlice[T any](less func(p, q T, i int) bool) *SortedSlice[T] {
+	notNil("less", less)
+	return &SortedSlice[T]{sorted[T]{less: less}}
+}
+
+// SortedSliceFrom creates a new *SortedSlice[T]
+// ordered by the given less function containing
+// all elements of the Enumerable e. The original
+// order of equal elements is kept.
+func SortedSliceFrom[T any](e Enumerable[T], less func(p, q T, i int) bool) (s *SortedSlice[T]) {
+	s = NewSortedSlice(less)
+	s.s = copySlice(e.Unwrap())
+	sort.SliceStable(s.s, func(i, j int) bool {
+		return less(s.s[i], s.s[j], i)
+	})
+	return
+}
+
+// Push inserts the passed value v at its position
+// in the SortedSlice. If equal elements are
+// contained, v is inserted after them.
+func (s *sorted[T]) Push(v T) {
+	s.insert(s.UpperBound(v), v)
+}
+
+func (s *sorted[T]) insert(i int, v T) {
+	var zero T
+	s.s = append(s.s, zero)
+	copy(s.s[i+1:], s.s[i:])
+	s.s[i] = v
+}
+
+// Append inserts all elements of Enumerable v
+// at their positions in the SortedSlice.
+func (s *sorted[T]) Append(v Enumerable[T]) {
+	v.Each(func(e T, _ int) {
+		s.Push(e)
+	})
+}
+
+// Replace safely replaces the value in the SortedSlice
+// at the given index i with the given value v and
+// returns true if the value was replaced. If there is
+// no value at i or v would break the order of the
+// SortedSlice, false is returned.
+func (s *sorted[T]) Replace(i int, v T) (ok bool) {
+	if i < 0 || i >= s.Len() {
+		return
+	}
+	if i > 0 && s.less(v, s.s[i-1], i-1) {
+		return
+	}
+	if i < s.Len()-1 && s.less(s.s[i+1], v, i+1) {
+		return
+	}
+	s.s[i] = v
+	return true
+}
+
+// Search returns the index of the first element
+// equal to v and true. If v is not contained, the
+// index where v would be inserted and false is
+// returned.
+func (s *sorted[T]) Search(v T) (int, bool) {
+	return BinarySearch[T](&s.slice, v, s.less)
+}
+
+// IndexOf returns the index of the first element
+// equal to v or -1, if v is not contained in the
+// SortedSlice.
+func (s *sorted[T]) IndexOf(v T) int {
+	if i, ok := s.Search(v); ok {
+		return i
+	}
+	return -1
+}
+
+// Contains returns true if an element equal to v
+// is contained in the SortedSlice.
+func (s *sorted[T]) Contains(v T) (ok bool) {
+	_, ok = s.Search(v)
+	return
+}
+
+// LowerBound returns the index of the first element
+// which is not less than v. If there is no such
+// element, the length of the SortedSlice is returned.
+func (s *sorted[T]) LowerBound(v T) int {
+	return sort.Search(len(s.s), func(i int) bool {
+		return !s.less(s.s[i], v, i)
+	})
+}
+
+// UpperBound returns the index of the first element
+// which is greater than v. If there is no such
+// element, the length of the SortedSlice is returned.
+func (s *sorted[T]) UpperBound(v T) int {
+	return sort.Search(len(s.s), func(i int) bool {
+		return s.less(v, s.s[i], i)
+	})
+}
+
+// RangeBetween returns all elements e of the
+// SortedSlice with lo <= e < hi as new Slice.
+func (s *sorted[T]) RangeBetween(lo, hi T) *SliceOf[T] {
+	i, j := s.LowerBound(lo), s.LowerBound(hi)
+	if j < i {
+		j = i
+	}
+	return Slice(copySlice(s.s[i:j]))
+}
+
+// Rank returns the number of elements in the
+// SortedSlice which are less than v.
+func (s *sorted[T]) Rank(v T) int {
+	return s.LowerBound(v)
+}
+
+// SortedSet is a SortedSlice which only contains
+// unique elements. Two elements are considered
+// equal when neither is less than the other.
+//
+// A SortedSet must be created using NewSortedSet
+// or SortedSetFrom.
+type SortedSet[T any] struct {
+	sorted[T]
+}
+
+var _ Enumerable[any] = (*SortedSet[any])(nil)
+
+// NewSortedSet creates a new empty *SortedSet[T]
+// ordered by the given less function, like
+// util.Asc or util.Desc.
+func NewSortedSet[T any](less func(p, q T, i int) bool) *SortedSet[T] {
+	notNil("less", less)
+	return &SortedSet[T]{sorted[T]{less: less}}
+}
+
+// SortedSetFrom creates a new *SortedSet[T]
+// ordered by the given less function containing
+// all elements of the Enumerable e. Of equal
+// elements, the first occurence is kept.
+func SortedSetFrom[T any](e Enumerable[T], less func(p, q T, i int) bool) (s *SortedSet[T]) {
+	s = NewSortedSet(less)
+	s.Append(e)
+	return
+}
+
+// Push inserts the passed value v at its position
+// in the SortedSet, if no equal element is
+// contained.
+func (s *SortedSet[T]) Push(v T) {
+	if i, ok := s.Search(v); !ok {
+		s.insert(i, v)
+	}
+}
+
+// Append inserts all elements of Enumerable v
+// which are not contained yet at their positions
+// in the SortedSet.
+func (s *SortedSet[T]) Append(v Enumerable[T]) {
+	v.Each(func(e T, _ int) {
+		s.Push(e)
+	})
+}
+
+// Replace safely replaces the value in the SortedSet
+// at the given index i with the given value v and
+// returns true if the value was replaced. If there is
+// no value at i or v would break the order or the
+// uniqueness of the SortedSet, false is returned.
+func (s *SortedSet[T]) Replace(i int, v T) (ok bool) {
+	if i < 0 || i >= s.Len() {
+		return
+	}
+	if i > 0 && !s.less(s.s[i-1], v, i-1) {
+		return
+	}
+	if i < s.Len()-1 && !s.less(v, s.s[i+1], i+1) {
+		return
+	}
+	s.s[i] = v
+	return true
+}
+
+// BinarySearch searches for v in the Enumerable s,
+// which must be sorted by the given less function.
+// It returns the index of the first element equal
+// to v and true. If v is not contained, the index
+// where v would be inserted and false is returned.
+func BinarySearch[T any](s Enumerable[T], v T, less func(p, q T, i int) bool) (int, bool) {
+	notNil("less", less)
+	i := sort.Search(s.Len(), func(i int) bool {
+		e, _ := s.At(i)
+		return !less(e, v, i)
+	})
+	if e, ok := s.At(i); ok && !less(v, e, i) {
+		return i, true
+	}
+	return i, false
+}
+
+// IsSorted returns true if the elements of the
+// Enumerable s are sorted in ascending order.
+func IsSorted[T constraints.Ordered](s Enumerable[T]) bool {
+	return IsSortedBy(s, func(p, q T, _ int) bool {
+		return p < q
+	})
+}
+
+// IsSortedBy returns true if the elements of the
+// Enumerable s are sorted by the given less
+// function.
+func IsSortedBy[T any](s Enumerable[T], less func(p, q T, i int) bool) bool {
+	notNil("less", less)
+	var prev T
+	return s.All(func(v T, i int) bool {
+		ok := i == 0 || !less(v, prev, i)
+		prev = v
+		return ok
+	})
+}
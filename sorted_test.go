@@ -0,0 +1,128 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zekrotja/sop/util"
+)
+
+func TestSortedSlice(t *testing.T) {
+	assertUnexposed(t, SortedSlice[int]{})
+	assertUnexposed(t, SortedSet[int]{})
+
+	s := NewSortedSlice(util.Asc[int])
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		s.Push(v)
+	}
+	assert.Equal(t, []int{1, 1, 3, 4, 5}, s.Unwrap())
+
+	s.Append(Slice([]int{2, 6}))
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 6}, s.Unwrap())
+
+	assert.Equal(t, 6, s.Pop())
+	assert.Equal(t, []int{1, 1}, s.Splice(0, 2).Unwrap())
+	assert.Equal(t, []int{2, 3, 4, 5}, s.Unwrap())
+
+	assert.Panics(t, func() {
+		NewSortedSlice[int](nil)
+	})
+}
+
+func TestSortedSliceStable(t *testing.T) {
+	type entry struct {
+		score int
+		name  string
+	}
+	less := util.ByDesc(func(e entry) int {
+		return e.score
+	})
+	s := SortedSliceFrom[entry](Slice([]entry{
+		{2, "a"}, {3, "b"}, {2, "c"},
+	}), less)
+	s.Push(entry{2, "d"})
+	s.Push(entry{3, "e"})
+	assert.Equal(t, []entry{
+		{3, "b"}, {3, "e"}, {2, "a"}, {2, "c"}, {2, "d"},
+	}, s.Unwrap())
+}
+
+func TestSortedSliceSearch(t *testing.T) {
+	s := SortedSliceFrom[int](Slice([]int{5, 1, 3, 3, 7}), util.Asc[int])
+
+	i, ok := s.Search(3)
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+	i, ok = s.Search(4)
+	assert.False(t, ok)
+	assert.Equal(t, 3, i)
+
+	assert.Equal(t, 4, s.IndexOf(7))
+	assert.Equal(t, -1, s.IndexOf(6))
+	assert.True(t, s.Contains(1))
+	assert.False(t, s.Contains(0))
+
+	assert.Equal(t, 1, s.LowerBound(3))
+	assert.Equal(t, 3, s.UpperBound(3))
+	assert.Equal(t, 0, s.LowerBound(0))
+	assert.Equal(t, 5, s.UpperBound(7))
+
+	assert.Equal(t, 1, s.Rank(3))
+	assert.Equal(t, 5, s.Rank(10))
+}
+
+func TestSortedSliceRangeBetween(t *testing.T) {
+	s := SortedSliceFrom[int](Range(0, 10), util.Asc[int])
+	assert.Equal(t, []int{3, 4, 5}, s.RangeBetween(3, 6).Unwrap())
+	assert.Equal(t, []int{8, 9}, s.RangeBetween(8, 20).Unwrap())
+	assert.Equal(t, []int{}, s.RangeBetween(6, 3).Unwrap())
+}
+
+func TestSortedSliceReplace(t *testing.T) {
+	s := SortedSliceFrom[int](Slice([]int{1, 3, 5}), util.Asc[int])
+	assert.True(t, s.Replace(1, 4))
+	assert.True(t, s.Replace(1, 5))
+	assert.False(t, s.Replace(1, 6))
+	assert.False(t, s.Replace(0, 6))
+	assert.False(t, s.Replace(3, 6))
+	assert.Equal(t, []int{1, 5, 5}, s.Unwrap())
+}
+
+func TestSortedSet(t *testing.T) {
+	s := SortedSetFrom[int](Slice([]int{3, 1, 3, 2, 1}), util.Asc[int])
+	assert.Equal(t, []int{1, 2, 3}, s.Unwrap())
+
+	s.Push(2)
+	s.Push(0)
+	s.Append(Slice([]int{4, 4, 1}))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, s.Unwrap())
+
+	assert.False(t, s.Replace(1, 2))
+	assert.False(t, s.Replace(1, 0))
+	assert.True(t, s.Replace(4, 5))
+	assert.Equal(t, []int{2, 3}, s.RangeBetween(2, 4).Unwrap())
+}
+
+func TestBinarySearch(t *testing.T) {
+	s := Slice([]int{9, 7, 7, 2})
+	i, ok := BinarySearch[int](s, 7, util.Desc[int])
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+
+	i, ok = BinarySearch[int](s, 5, util.Desc[int])
+	assert.False(t, ok)
+	assert.Equal(t, 3, i)
+
+	i, ok = BinarySearch[int](NewDeque[int](), 5, util.Asc[int])
+	assert.False(t, ok)
+	assert.Equal(t, 0, i)
+}
+
+func TestIsSorted(t *testing.T) {
+	assert.True(t, IsSorted[int](Slice([]int{1, 2, 2, 3})))
+	assert.False(t, IsSorted[int](Slice([]int{1, 3, 2})))
+	assert.True(t, IsSorted[int](Slice([]int{})))
+
+	assert.True(t, IsSortedBy[int](Slice([]int{3, 2, 2, 1}), util.Desc[int]))
+	assert.False(t, IsSortedBy[int](Slice([]int{1, 2}), util.Desc[int]))
+}
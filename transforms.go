@@ -1,6 +1,10 @@
 package sop
 
-import "golang.org/x/exp/constraints"
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
 
 // Map takes a Slice s and performs the passed function f
 // on each element of the Slice s. The return value of the
@@ -9,7 +13,7 @@ import "golang.org/x/exp/constraints"
 //
 // f is getting passed the value v at the given position
 // in the slice as well as the current index i.
-func Map[TIn, TOut any](s Enumerable[TIn], f func(v TIn, i int) TOut) Enumerable[TOut] {
+func Map[TIn, TOut any](s Enumerable[TIn], f func(v TIn, i int) TOut) *SliceOf[TOut] {
 	notNil("f", f)
 	res := newSliceFrom[TIn, TOut](s)
 	s.Each(func(v TIn, i int) {
@@ -21,12 +25,12 @@ func Map[TIn, TOut any](s Enumerable[TIn], f func(v TIn, i int) TOut) Enumerable
 // Flat takes a slice containing arrays and creates a
 // new slice with all elements of the sub-arrays
 // arranged into a one-dimensional array.
-func Flat[T any](s Enumerable[[]T]) (res Enumerable[T]) {
+func Flat[T any](s Enumerable[[]T]) (res *SliceOf[T]) {
 	var i int
 	s.Each(func(v []T, _ int) {
 		i += len(v)
 	})
-	r := &slice[T]{make([]T, i)}
+	r := Slice(make([]T, i))
 	i = 0
 	s.Each(func(v []T, _ int) {
 		for _, uv := range v {
@@ -45,7 +49,7 @@ func Flat[T any](s Enumerable[[]T]) (res Enumerable[T]) {
 //
 // f is therefore getting passed the current
 // index i in the slice.
-func Fill[T any](n int, f func(i int) T) (res Enumerable[T]) {
+func Fill[T any](n int, f func(i int) T) (res *SliceOf[T]) {
 	notNil("f", f)
 	r := Slice(make([]T, n))
 	for i := 0; i < n; i++ {
@@ -58,7 +62,7 @@ func Fill[T any](n int, f func(i int) T) (res Enumerable[T]) {
 // Range creates an integer Slice filled with
 // sequential numbers starting with s and ending
 // with s+n-1 [n, s+n).
-func Range[T constraints.Integer](s, n T) (res Enumerable[T]) {
+func Range[T constraints.Integer](s, n T) (res *SliceOf[T]) {
 	r := Slice(make([]T, n))
 	for i := s; i < s+n; i++ {
 		r.s[i-s] = i
@@ -107,10 +111,8 @@ func GroupE[TVal any, TMKey comparable, TMVal any](
 // key-value tuples from the given map entries.
 func MapFlat[TKey comparable, TVal any](
 	m map[TKey]TVal,
-) Enumerable[Tuple[TKey, TVal]] {
-	s := &slice[Tuple[TKey, TVal]]{
-		s: make([]Tuple[TKey, TVal], len(m)),
-	}
+) *SliceOf[Tuple[TKey, TVal]] {
+	s := Slice(make([]Tuple[TKey, TVal], len(m)))
 	i := 0
 	for k, v := range m {
 		s.s[i] = Tuple[TKey, TVal]{k, v}
@@ -118,3 +120,119 @@ func MapFlat[TKey comparable, TVal any](
 	}
 	return s
 }
+
+// SortBy re-orders the Enumerable s in ascending
+// order of the keys returned by function f for
+// each element. The original order of elements
+// with equal keys is kept.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i. f is called only once for each
+// element.
+func SortBy[T any, K constraints.Ordered](s Enumerable[T], f func(v T, i int) K) *SliceOf[T] {
+	notNil("f", f)
+	src := s.Unwrap()
+	keys := make([]K, len(src))
+	idx := make([]int, len(src))
+	for i, v := range src {
+		keys[i] = f(v, i)
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return keys[idx[i]] < keys[idx[j]]
+	})
+	res := newSliceFrom[T, T](s)
+	for i, j := range idx {
+		res[i] = src[j]
+	}
+	return Slice(res)
+}
+
+// Fold applies the function f on each element of
+// the Enumerable s and the accumulator acc, which
+// starts with the value seed, and returns the
+// final accumulator. Unlike Aggregate, the
+// accumulator may be of a different type than
+// the elements.
+//
+// f is getting passed the current accumulator acc,
+// the value v at the current position as well as
+// the current index i.
+func Fold[T, A any](s Enumerable[T], seed A, f func(acc A, v T, i int) A) A {
+	notNil("f", f)
+	s.Each(func(v T, i int) {
+		seed = f(seed, v, i)
+	})
+	return seed
+}
+
+// Reduce works like Aggregate but returns false
+// if the Enumerable s is empty instead of silently
+// returning default of T.
+func Reduce[T any](s Enumerable[T], f func(a, b T) T) (res T, ok bool) {
+	notNil("f", f)
+	if s.Len() == 0 {
+		return
+	}
+	return s.Aggregate(f), true
+}
+
+// Scan works like Fold but returns every
+// intermediate accumulator as new Enumerable,
+// which is useful for running totals.
+//
+// f is getting passed the current accumulator acc,
+// the value v at the current position as well as
+// the current index i.
+func Scan[T, A any](s Enumerable[T], seed A, f func(acc A, v T, i int) A) *SliceOf[A] {
+	notNil("f", f)
+	res := newSliceFrom[T, A](s)
+	s.Each(func(v T, i int) {
+		seed = f(seed, v, i)
+		res[i] = seed
+	})
+	return Slice(res)
+}
+
+// Distinct returns a new Enumerable containing
+// each element of s only once in the order of
+// their first occurence.
+func Distinct[T comparable](s Enumerable[T]) *SliceOf[T] {
+	return DistinctBy(s, identity[T])
+}
+
+// DistinctBy returns a new Enumerable containing
+// only the first element of s for each key returned
+// by f, so that it can also be used on elements
+// which are not comparable.
+//
+// f is getting passed the value v at the
+// current position as well as the current
+// index i.
+func DistinctBy[T any, K comparable](s Enumerable[T], f func(v T, i int) K) *SliceOf[T] {
+	notNil("f", f)
+	seen := make(map[K]struct{})
+	return Slice(s.Filter(func(v T, i int) bool {
+		k := f(v, i)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	}).Unwrap())
+}
+
+// DedupeAdjacent returns a new Enumerable where
+// consecutive runs of equal elements of s are
+// reduced to their first element. On sorted input,
+// this is equal to Distinct without the need of
+// an index.
+func DedupeAdjacent[T comparable](s Enumerable[T]) *SliceOf[T] {
+	var prev T
+	return Slice(s.Filter(func(v T, i int) (ok bool) {
+		ok = i == 0 || v != prev
+		prev = v
+		return
+	}).Unwrap())
+}
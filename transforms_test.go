@@ -84,9 +84,9 @@ func TestGroupE(t *testing.T) {
 	})
 
 	assert.Equal(t, map[string]Enumerable[int]{
-		"a": &slice[int]{[]int{1, 2, 3}},
-		"b": &slice[int]{[]int{1, 2}},
-		"c": &slice[int]{[]int{1}},
+		"a": Slice([]int{1, 2, 3}),
+		"b": Slice([]int{1, 2}),
+		"c": Slice([]int{1}),
 	}, m)
 }
 
@@ -104,3 +104,106 @@ func TestMapFlat(t *testing.T) {
 	}
 	assert.ElementsMatch(t, st, s.Unwrap())
 }
+
+func TestSortBy(t *testing.T) {
+	w := Slice([]string{"ccc", "a", "bb", "d", "ee"})
+	r := SortBy[string](w, func(v string, _ int) int {
+		return len(v)
+	})
+	assert.Equal(t, []string{"a", "d", "bb", "ee", "ccc"}, r.Unwrap())
+
+	r = SortBy[string](w, func(_ string, i int) int {
+		return -i
+	})
+	assert.Equal(t, []string{"ee", "d", "bb", "a", "ccc"}, r.Unwrap())
+
+	assert.Panics(t, func() {
+		SortBy[string, int](w, nil)
+	})
+}
+
+func TestFold(t *testing.T) {
+	w := Slice([]int{1, 2, 3})
+	r := Fold[int](w, "", func(acc string, v, i int) string {
+		return acc + fmt.Sprintf("%d:%d;", i, v)
+	})
+	assert.Equal(t, "0:1;1:2;2:3;", r)
+
+	assert.Equal(t, 5, Fold[int](Slice([]int{}), 5, func(acc, v, _ int) int {
+		return acc + v
+	}))
+
+	assert.Panics(t, func() {
+		Fold[int, int](w, 0, nil)
+	})
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(a, b int) int {
+		return a + b
+	}
+
+	r, ok := Reduce[int](Range(1, 4), sum)
+	assert.True(t, ok)
+	assert.Equal(t, 10, r)
+
+	r, ok = Reduce[int](Slice([]int{}), sum)
+	assert.False(t, ok)
+	assert.Equal(t, 0, r)
+}
+
+func TestScan(t *testing.T) {
+	r := Scan[int](Range(1, 5), 0, func(acc, v, _ int) int {
+		return acc + v
+	})
+	assert.Equal(t, []int{1, 3, 6, 10, 15}, r.Unwrap())
+
+	avg := Scan[float64](Slice([]float64{2, 4, 6}), 0.0, func(acc, v float64, i int) float64 {
+		return acc + (v-acc)/float64(i+1)
+	})
+	assert.Equal(t, []float64{2, 3, 4}, avg.Unwrap())
+
+	assert.Equal(t, []int{}, Scan[int](Slice([]int{}), 0, func(acc, v, _ int) int {
+		return acc + v
+	}).Unwrap())
+}
+
+func TestDistinct(t *testing.T) {
+	r := Distinct[int](Slice([]int{3, 1, 3, 2, 1, 4}))
+	assert.Equal(t, []int{3, 1, 2, 4}, r.Unwrap())
+
+	r = Distinct[int](Slice([]int{}))
+	assert.Equal(t, []int{}, r.Unwrap())
+}
+
+func TestDistinctBy(t *testing.T) {
+	type obj struct {
+		ID   int
+		Tags []string
+	}
+
+	w := Slice([]obj{
+		{1, []string{"a"}},
+		{2, []string{"b"}},
+		{1, []string{"c"}},
+	})
+	r := DistinctBy[obj](w, func(v obj, _ int) int {
+		return v.ID
+	})
+	assert.Equal(t, []obj{
+		{1, []string{"a"}},
+		{2, []string{"b"}},
+	}, r.Unwrap())
+
+	assert.Panics(t, func() {
+		DistinctBy[obj, int](w, nil)
+	})
+}
+
+func TestDedupeAdjacent(t *testing.T) {
+	r := DedupeAdjacent[int](Slice([]int{1, 1, 2, 3, 3, 3, 1, 1}))
+	assert.Equal(t, []int{1, 2, 3, 1}, r.Unwrap())
+
+	r = DedupeAdjacent[int](Slice([]int{0, 0, 1}))
+	assert.Equal(t, []int{0, 1}, r.Unwrap())
+}
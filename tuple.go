@@ -6,3 +6,27 @@ type Tuple[T1, T2 any] struct {
 	V1 T1
 	V2 T2
 }
+
+// Tuple3 groups together three
+// values.
+type Tuple3[T1, T2, T3 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+}
+
+// Tuple4 groups together four
+// values.
+type Tuple4[T1, T2, T3, T4 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+}
+
+// NewTuple creates a new Tuple from the given
+// values v1 and v2. It can be passed as result
+// selector to functions like Join.
+func NewTuple[T1, T2 any](v1 T1, v2 T2) Tuple[T1, T2] {
+	return Tuple[T1, T2]{v1, v2}
+}
@@ -20,3 +20,9 @@ func copySlice[T any](t []T) (s []T) {
 	copy(s, t)
 	return
 }
+
+func positive(name string, v int) {
+	if v <= 0 {
+		panic(fmt.Sprintf("parameter %s must be positive", name))
+	}
+}
@@ -0,0 +1,63 @@
+package util
+
+import "golang.org/x/exp/constraints"
+
+// Less is a function used to sort enumerables
+// which can be composed with further orderings.
+//
+// The meaning of the index i depends on the
+// function the Less is passed to. For example,
+// SortStable passes the original index of p,
+// while Sort passes an internal index of the
+// sorting algorithm. By and ByDesc ignore it,
+// so their key functions only get the value,
+// and the composed Less functions pass it on.
+type Less[T any] func(p, q T, i int) bool
+
+// By returns a Less function used to sort
+// enumerables in ascending order of the key
+// returned by f.
+func By[T any, K constraints.Ordered](f func(v T) K) Less[T] {
+	return func(p, q T, _ int) bool {
+		return f(p) < f(q)
+	}
+}
+
+// ByDesc returns a Less function used to sort
+// enumerables in descending order of the key
+// returned by f.
+func ByDesc[T any, K constraints.Ordered](f func(v T) K) Less[T] {
+	return func(p, q T, _ int) bool {
+		return f(p) > f(q)
+	}
+}
+
+// ThenBy returns a Less function which orders
+// elements by l and, if they are equal in
+// terms of l, by next.
+func (l Less[T]) ThenBy(next func(p, q T, i int) bool) Less[T] {
+	return func(p, q T, i int) bool {
+		if l(p, q, i) {
+			return true
+		}
+		if l(q, p, i) {
+			return false
+		}
+		return next(p, q, i)
+	}
+}
+
+// ThenByDesc returns a Less function which orders
+// elements by l and, if they are equal in terms
+// of l, by next in reversed order.
+func (l Less[T]) ThenByDesc(next func(p, q T, i int) bool) Less[T] {
+	return l.ThenBy(Less[T](next).Reverse())
+}
+
+// Reverse returns a Less function which orders
+// elements in the reversed order of l.
+func (l Less[T]) Reverse() Less[T] {
+	return func(p, q T, i int) bool {
+		return l(q, p, i)
+	}
+}
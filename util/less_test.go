@@ -0,0 +1,57 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zekrotja/sop"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+var people = []person{
+	{"b", 30},
+	{"a", 30},
+	{"c", 20},
+	{"a", 20},
+}
+
+func name(p person) string {
+	return p.Name
+}
+
+func age(p person) int {
+	return p.Age
+}
+
+func TestBy(t *testing.T) {
+	s := sop.Slice(people)
+
+	r := s.SortStable(By(age))
+	assert.Equal(t, []person{{"c", 20}, {"a", 20}, {"b", 30}, {"a", 30}}, r.Unwrap())
+
+	r = s.SortStable(ByDesc(name))
+	assert.Equal(t, []person{{"c", 20}, {"b", 30}, {"a", 30}, {"a", 20}}, r.Unwrap())
+}
+
+func TestThenBy(t *testing.T) {
+	s := sop.Slice(people)
+
+	r := s.Sort(By(age).ThenBy(By(name)))
+	assert.Equal(t, []person{{"a", 20}, {"c", 20}, {"a", 30}, {"b", 30}}, r.Unwrap())
+
+	r = s.Sort(By(age).ThenByDesc(By(name)))
+	assert.Equal(t, []person{{"c", 20}, {"a", 20}, {"b", 30}, {"a", 30}}, r.Unwrap())
+
+	r = s.Sort(By(name).ThenBy(ByDesc(age)))
+	assert.Equal(t, []person{{"a", 30}, {"a", 20}, {"b", 30}, {"c", 20}}, r.Unwrap())
+}
+
+func TestReverse(t *testing.T) {
+	s := sop.Slice([]int{2, 4, 5, 1, 3})
+	r := s.Sort(Less[int](Asc[int]).Reverse())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, r.Unwrap())
+}
@@ -0,0 +1,136 @@
+package sop
+
+// Chunk splits the Enumerable s into consecutive
+// chunks of the given size. The last chunk
+// contains the remaining elements and may
+// therefore be smaller than size.
+//
+// The result can be flattened again using Flat.
+func Chunk[T any](s Enumerable[T], size int) *SliceOf[[]T] {
+	positive("size", size)
+	src := s.Unwrap()
+	res := make([][]T, 0, (len(src)+size-1)/size)
+	for i := 0; i < len(src); i += size {
+		end := i + size
+		if end > len(src) {
+			end = len(src)
+		}
+		res = append(res, copySlice(src[i:end]))
+	}
+	return Slice(res)
+}
+
+// Window creates sliding windows of the given
+// size over the Enumerable s where each window
+// starts step elements after the previous one.
+// Trailing windows smaller than size are
+// dropped.
+func Window[T any](s Enumerable[T], size, step int) *SliceOf[[]T] {
+	positive("size", size)
+	positive("step", step)
+	src := s.Unwrap()
+	n := 0
+	if len(src) >= size {
+		n = (len(src)-size)/step + 1
+	}
+	res := make([][]T, 0, n)
+	for i := 0; i+size <= len(src); i += step {
+		res = append(res, copySlice(src[i:i+size]))
+	}
+	return Slice(res)
+}
+
+// Pairwise creates a Tuple of each element
+// in the Enumerable s and its successor.
+func Pairwise[T any](s Enumerable[T]) *SliceOf[Tuple[T, T]] {
+	src := s.Unwrap()
+	if len(src) < 2 {
+		return Slice([]Tuple[T, T]{})
+	}
+	res := make([]Tuple[T, T], len(src)-1)
+	for i := range res {
+		res[i] = Tuple[T, T]{src[i], src[i+1]}
+	}
+	return Slice(res)
+}
+
+// LazyChunk adds a stage to the Query q which
+// works like Chunk but only buffers the elements
+// of the current chunk.
+func LazyChunk[T any](q *Query[T], size int) *Query[[]T] {
+	positive("size", size)
+	return &Query[[]T]{func() iterator[[]T] {
+		next := q.iter()
+		return func() (res []T, ok bool) {
+			for v, vok := next(); vok; v, vok = next() {
+				res = append(res, v)
+				if len(res) == size {
+					break
+				}
+			}
+			ok = len(res) != 0
+			return
+		}
+	}}
+}
+
+// LazyWindow adds a stage to the Query q which
+// works like Window but only buffers the elements
+// of the current window.
+func LazyWindow[T any](q *Query[T], size, step int) *Query[[]T] {
+	positive("size", size)
+	positive("step", step)
+	return &Query[[]T]{func() iterator[[]T] {
+		next := q.iter()
+		buf := make([]T, 0, size)
+		var started bool
+		return func() (res []T, ok bool) {
+			if started {
+				if step < size {
+					buf = buf[:copy(buf, buf[step:])]
+				} else {
+					buf = buf[:0]
+					for i := 0; i < step-size; i++ {
+						if _, vok := next(); !vok {
+							return
+						}
+					}
+				}
+			}
+			started = true
+			for len(buf) < size {
+				v, vok := next()
+				if !vok {
+					return
+				}
+				buf = append(buf, v)
+			}
+			return copySlice(buf), true
+		}
+	}}
+}
+
+// LazyPairwise adds a stage to the Query q
+// which works like Pairwise.
+func LazyPairwise[T any](q *Query[T]) *Query[Tuple[T, T]] {
+	return &Query[Tuple[T, T]]{func() iterator[Tuple[T, T]] {
+		next := q.iter()
+		var prev T
+		var started bool
+		return func() (res Tuple[T, T], ok bool) {
+			if !started {
+				started = true
+				if prev, ok = next(); !ok {
+					return
+				}
+			}
+			v, ok := next()
+			if !ok {
+				return
+			}
+			res = Tuple[T, T]{prev, v}
+			prev = v
+			return
+		}
+	}}
+}
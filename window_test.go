@@ -0,0 +1,73 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	w := Range(1, 7)
+	r := Chunk[int](w, 3)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, r.Unwrap())
+	assert.Equal(t, w.Unwrap(), Flat[int](r).Unwrap())
+
+	r = Chunk[int](w, 10)
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5, 6, 7}}, r.Unwrap())
+
+	r = Chunk[int](Slice([]int{}), 2)
+	assert.Equal(t, [][]int{}, r.Unwrap())
+
+	assert.Panics(t, func() {
+		Chunk[int](w, 0)
+	})
+}
+
+func TestWindow(t *testing.T) {
+	w := Range(1, 6)
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}},
+		Window[int](w, 3, 1).Unwrap())
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5, 6}},
+		Window[int](w, 2, 2).Unwrap())
+	assert.Equal(t, [][]int{{1, 2}, {4, 5}},
+		Window[int](w, 2, 3).Unwrap())
+	assert.Equal(t, [][]int{},
+		Window[int](w, 7, 1).Unwrap())
+	assert.Equal(t, [][]int{},
+		Window[int](Slice([]int{}), 1, 1).Unwrap())
+
+	assert.Panics(t, func() {
+		Window[int](w, 2, 0)
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	r := Pairwise[int](Range(1, 4))
+	assert.Equal(t, []Tuple[int, int]{{1, 2}, {2, 3}, {3, 4}}, r.Unwrap())
+
+	r = Pairwise[int](Slice([]int{1}))
+	assert.Equal(t, []Tuple[int, int]{}, r.Unwrap())
+}
+
+func TestLazyChunk(t *testing.T) {
+	q := Lazy[int](Range(1, 7))
+	assert.Equal(t, Chunk[int](Range(1, 7), 3).Unwrap(), LazyChunk(q, 3).Unwrap())
+	assert.Equal(t, [][]int{{1, 2}}, LazyChunk(q, 2).Take(1).Unwrap())
+	assert.Equal(t, [][]int(nil), LazyChunk(q.Skip(10), 2).Unwrap())
+}
+
+func TestLazyWindow(t *testing.T) {
+	w := Range(1, 6)
+	q := Lazy[int](w)
+	for _, c := range [][2]int{{3, 1}, {2, 2}, {2, 3}, {7, 1}, {1, 1}, {1, 4}} {
+		assert.Equal(t,
+			Window[int](w, c[0], c[1]).Unwrap(),
+			append([][]int{}, LazyWindow(q, c[0], c[1]).Unwrap()...))
+	}
+}
+
+func TestLazyPairwise(t *testing.T) {
+	q := Lazy[int](Range(1, 4))
+	assert.Equal(t, Pairwise[int](Range(1, 4)).Unwrap(), LazyPairwise(q).Unwrap())
+	assert.Equal(t, []Tuple[int, int](nil), LazyPairwise(q.Take(1)).Unwrap())
+}
@@ -0,0 +1,120 @@
+package sop
+
+// Zip combines the elements of the Enumerables
+// a and b at the same position into Tuples. The
+// length of the result is the length of the
+// shorter Enumerable.
+func Zip[A, B any](a Enumerable[A], b Enumerable[B]) *SliceOf[Tuple[A, B]] {
+	return ZipWith(a, b, func(va A, vb B, _ int) Tuple[A, B] {
+		return Tuple[A, B]{va, vb}
+	})
+}
+
+// ZipWith performs the function f on the elements
+// of the Enumerables a and b at the same position
+// and packs the results into a new Enumerable. The
+// length of the result is the length of the shorter
+// Enumerable.
+//
+// f is getting passed the values va and vb at the
+// current position as well as the current index i.
+func ZipWith[A, B, R any](a Enumerable[A], b Enumerable[B], f func(va A, vb B, i int) R) *SliceOf[R] {
+	notNil("f", f)
+	sa, sb := a.Unwrap(), b.Unwrap()
+	n := len(sa)
+	if len(sb) < n {
+		n = len(sb)
+	}
+	res := make([]R, n)
+	for i := range res {
+		res[i] = f(sa[i], sb[i], i)
+	}
+	return Slice(res)
+}
+
+// ZipLongest works like Zip but the length of
+// the result is the length of the longer
+// Enumerable. Missing elements of the shorter
+// Enumerable are filled with fillA or fillB.
+func ZipLongest[A, B any](a Enumerable[A], b Enumerable[B], fillA A, fillB B) *SliceOf[Tuple[A, B]] {
+	sa, sb := a.Unwrap(), b.Unwrap()
+	n := len(sa)
+	if len(sb) > n {
+		n = len(sb)
+	}
+	res := make([]Tuple[A, B], n)
+	for i := range res {
+		res[i] = Tuple[A, B]{fillA, fillB}
+		if i < len(sa) {
+			res[i].V1 = sa[i]
+		}
+		if i < len(sb) {
+			res[i].V2 = sb[i]
+		}
+	}
+	return Slice(res)
+}
+
+// Unzip splits the Tuples in the Enumerable s
+// into two Enumerables containing the first
+// and second values.
+func Unzip[A, B any](s Enumerable[Tuple[A, B]]) (*SliceOf[A], *SliceOf[B]) {
+	ra := newSliceFrom[Tuple[A, B], A](s)
+	rb := newSliceFrom[Tuple[A, B], B](s)
+	s.Each(func(v Tuple[A, B], i int) {
+		ra[i], rb[i] = v.V1, v.V2
+	})
+	return Slice(ra), Slice(rb)
+}
+
+// Zip3 works like Zip but combines the elements
+// of three Enumerables into Tuple3s.
+func Zip3[A, B, C any](
+	a Enumerable[A],
+	b Enumerable[B],
+	c Enumerable[C],
+) *SliceOf[Tuple3[A, B, C]] {
+	return ZipWith[Tuple[A, B]](Zip(a, b), c, func(ab Tuple[A, B], vc C, _ int) Tuple3[A, B, C] {
+		return Tuple3[A, B, C]{ab.V1, ab.V2, vc}
+	})
+}
+
+// Zip4 works like Zip but combines the elements
+// of four Enumerables into Tuple4s.
+func Zip4[A, B, C, D any](
+	a Enumerable[A],
+	b Enumerable[B],
+	c Enumerable[C],
+	d Enumerable[D],
+) *SliceOf[Tuple4[A, B, C, D]] {
+	return ZipWith[Tuple[A, B], Tuple[C, D]](Zip(a, b), Zip(c, d), func(ab Tuple[A, B], cd Tuple[C, D], _ int) Tuple4[A, B, C, D] {
+		return Tuple4[A, B, C, D]{ab.V1, ab.V2, cd.V1, cd.V2}
+	})
+}
+
+// Unzip3 splits the Tuple3s in the Enumerable s
+// into three Enumerables.
+func Unzip3[A, B, C any](s Enumerable[Tuple3[A, B, C]]) (*SliceOf[A], *SliceOf[B], *SliceOf[C]) {
+	ra := newSliceFrom[Tuple3[A, B, C], A](s)
+	rb := newSliceFrom[Tuple3[A, B, C], B](s)
+	rc := newSliceFrom[Tuple3[A, B, C], C](s)
+	s.Each(func(v Tuple3[A, B, C], i int) {
+		ra[i], rb[i], rc[i] = v.V1, v.V2, v.V3
+	})
+	return Slice(ra), Slice(rb), Slice(rc)
+}
+
+// Unzip4 splits the Tuple4s in the Enumerable s
+// into four Enumerables.
+func Unzip4[A, B, C, D any](
+	s Enumerable[Tuple4[A, B, C, D]],
+) (*SliceOf[A], *SliceOf[B], *SliceOf[C], *SliceOf[D]) {
+	ra := newSliceFrom[Tuple4[A, B, C, D], A](s)
+	rb := newSliceFrom[Tuple4[A, B, C, D], B](s)
+	rc := newSliceFrom[Tuple4[A, B, C, D], C](s)
+	rd := newSliceFrom[Tuple4[A, B, C, D], D](s)
+	s.Each(func(v Tuple4[A, B, C, D], i int) {
+		ra[i], rb[i], rc[i], rd[i] = v.V1, v.V2, v.V3, v.V4
+	})
+	return Slice(ra), Slice(rb), Slice(rc), Slice(rd)
+}
@@ -0,0 +1,77 @@
+package sop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	a := Slice([]int{1, 2, 3})
+	b := Slice([]string{"a", "b"})
+
+	r := Zip[int, string](a, b)
+	assert.Equal(t, []Tuple[int, string]{{1, "a"}, {2, "b"}}, r.Unwrap())
+
+	r = Zip[int, string](a, Slice([]string{}))
+	assert.Equal(t, []Tuple[int, string]{}, r.Unwrap())
+}
+
+func TestZipWith(t *testing.T) {
+	a := Slice([]int{1, 2, 3})
+	b := Slice([]int{10, 20, 30, 40})
+
+	r := ZipWith[int, int](a, b, func(va, vb, i int) int {
+		return va + vb + i
+	})
+	assert.Equal(t, []int{11, 23, 35}, r.Unwrap())
+
+	assert.Panics(t, func() {
+		ZipWith[int, int, int](a, b, nil)
+	})
+}
+
+func TestZipLongest(t *testing.T) {
+	a := Slice([]int{1, 2, 3})
+	b := Slice([]string{"a"})
+
+	r := ZipLongest[int, string](a, b, -1, "-")
+	assert.Equal(t, []Tuple[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}, r.Unwrap())
+
+	r = ZipLongest[int, string](Slice([]int{}), b, -1, "-")
+	assert.Equal(t, []Tuple[int, string]{{-1, "a"}}, r.Unwrap())
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip[int, string](Zip[int, string](Slice([]int{1, 2}), Slice([]string{"a", "b"})))
+	assert.Equal(t, []int{1, 2}, a.Unwrap())
+	assert.Equal(t, []string{"a", "b"}, b.Unwrap())
+}
+
+func TestZip3(t *testing.T) {
+	r := Zip3[int, string, bool](
+		Slice([]int{1, 2, 3}),
+		Slice([]string{"a", "b"}),
+		Slice([]bool{true, false, true}))
+	assert.Equal(t, []Tuple3[int, string, bool]{{1, "a", true}, {2, "b", false}}, r.Unwrap())
+
+	a, b, c := Unzip3[int, string, bool](r)
+	assert.Equal(t, []int{1, 2}, a.Unwrap())
+	assert.Equal(t, []string{"a", "b"}, b.Unwrap())
+	assert.Equal(t, []bool{true, false}, c.Unwrap())
+}
+
+func TestZip4(t *testing.T) {
+	r := Zip4[int, string, bool, float64](
+		Slice([]int{1, 2}),
+		Slice([]string{"a", "b"}),
+		Slice([]bool{true, false}),
+		Slice([]float64{0.5}))
+	assert.Equal(t, []Tuple4[int, string, bool, float64]{{1, "a", true, 0.5}}, r.Unwrap())
+
+	a, b, c, d := Unzip4[int, string, bool, float64](r)
+	assert.Equal(t, []int{1}, a.Unwrap())
+	assert.Equal(t, []string{"a"}, b.Unwrap())
+	assert.Equal(t, []bool{true}, c.Unwrap())
+	assert.Equal(t, []float64{0.5}, d.Unwrap())
+}